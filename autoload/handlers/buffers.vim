@@ -0,0 +1,257 @@
+" s:BufInfo converts a dictionary returned by getbufinfo() into
+" the BufInfo message defined in proto/buffers.proto.
+function! s:BufInfo(info)
+    let signs = []
+    for sign in get(a:info, "signs", [])
+        call add(signs, {
+                    \ "id": string(sign["id"]),
+                    \ "lnum": sign["lnum"],
+                    \ "name": sign["name"]
+                    \})
+    endfor
+    return {
+                \ "bufnr": a:info["bufnr"],
+                \ "changed": a:info["changed"] ? v:true : v:false,
+                \ "changedTick": a:info["changedtick"],
+                \ "hidden": a:info["hidden"] ? v:true : v:false,
+                \ "lastUsed": get(a:info, "lastused", 0),
+                \ "listed": a:info["listed"] ? v:true : v:false,
+                \ "lnum": a:info["lnum"],
+                \ "lineCount": get(a:info, "linecount", 0),
+                \ "loaded": a:info["loaded"] ? v:true : v:false,
+                \ "name": a:info["name"],
+                \ "signs": signs,
+                \ "windows": a:info["windows"],
+                \ "popups": get(a:info, "popups", [])
+                \}
+endfunc
+
+function! handlers#buffers#GetBufInfo(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "GetBufInfo")
+        return
+    endif
+    let body = a:envelope["body"]
+
+    if has_key(body, "bufn")
+        let infos = getbufinfo(str2nr(body["bufn"]))
+    elseif has_key(body, "bufName")
+        let bufnr = bufnr(body["bufName"])
+        let infos = bufnr == -1 ? [] : getbufinfo(bufnr)
+    else
+        let infos = getbufinfo()
+    endif
+
+    let a:envelope["body"] = { "buffers": map(infos, {_, info -> s:BufInfo(info)}) }
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
+
+" s:Range resolves the one-based, inclusive start and end of a request
+" against the buffer's line count. An end of zero refers to the last line.
+" An empty list is returned if the range is outside of the buffer.
+function! s:Range(bufnr, body)
+    let line_count = len(getbufline(a:bufnr, 1, "$"))
+    let start = rpc#Int(a:body, "start")
+    let end = rpc#Int(a:body, "end")
+    if end == 0
+        let end = line_count
+    endif
+    if start < 1 || end > line_count || start > end
+        return []
+    endif
+    return [start, end]
+endfunc
+
+" s:Edit validates the buffer and changedtick of an editing request and
+" calls a:Func with the buffer number when they are valid.
+"
+" a:Func returns a BufferEditResult status, the buffer's changedtick is
+" filled in after it returns.
+function! s:Edit(channel, envelope, Func)
+    let body = a:envelope["body"]
+    let bufnr = rpc#Int(body, "bufnr")
+
+    if !bufexists(bufnr)
+        let a:envelope["body"] = { "status": "NOT_FOUND" }
+        call ch_sendexpr(a:channel, a:envelope)
+        return
+    endif
+    call bufload(bufnr)
+
+    let tick = getbufvar(bufnr, "changedtick")
+    if tick != rpc#Int(body, "changedTick")
+        let a:envelope["body"] = { "status": "CONFLICT", "changedTick": tick }
+        call ch_sendexpr(a:channel, a:envelope)
+        return
+    endif
+
+    let status = a:Func(bufnr)
+    let a:envelope["body"] = {
+                \ "status": status,
+                \ "changedTick": getbufvar(bufnr, "changedtick")
+                \}
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
+
+function! handlers#buffers#GetLines(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "GetLines")
+        return
+    endif
+    let body = a:envelope["body"]
+    let bufnr = rpc#Int(body, "bufnr")
+
+    if !bufexists(bufnr)
+        call rpc#error#Reply(a:channel, a:envelope, "NOT_FOUND", "buffer " . bufnr . " not found")
+        return
+    endif
+    call bufload(bufnr)
+
+    let range = s:Range(bufnr, body)
+    let a:envelope["body"] = {
+                \ "lines": empty(range) ? [] : getbufline(bufnr, range[0], range[1]),
+                \ "changedTick": getbufvar(bufnr, "changedtick")
+                \}
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
+
+function! handlers#buffers#SetLines(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "SetLines")
+        return
+    endif
+    let body = a:envelope["body"]
+
+    function! s:SetLines(bufnr) closure
+        let range = s:Range(a:bufnr, body)
+        if empty(range)
+            return "INVALID_RANGE"
+        endif
+        let lines = get(body, "lines", [])
+        if len(lines) == range[1] - range[0] + 1
+            call setbufline(a:bufnr, range[0], lines)
+            return "APPLIED"
+        endif
+        let whole_buffer = range[0] == 1 && range[1] == len(getbufline(a:bufnr, 1, "$"))
+        call deletebufline(a:bufnr, range[0], range[1])
+        if !empty(lines)
+            " deleting every line leaves a single empty line behind.
+            if whole_buffer
+                call setbufline(a:bufnr, 1, lines)
+            else
+                call appendbufline(a:bufnr, range[0] - 1, lines)
+            endif
+        endif
+        return "APPLIED"
+    endfunc
+
+    call s:Edit(a:channel, a:envelope, function("s:SetLines"))
+endfunc
+
+function! handlers#buffers#InsertLines(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "InsertLines")
+        return
+    endif
+    let body = a:envelope["body"]
+
+    function! s:InsertLines(bufnr) closure
+        let lnum = rpc#Int(body, "lnum")
+        if lnum > len(getbufline(a:bufnr, 1, "$"))
+            return "INVALID_RANGE"
+        endif
+        call appendbufline(a:bufnr, lnum, get(body, "lines", []))
+        return "APPLIED"
+    endfunc
+
+    call s:Edit(a:channel, a:envelope, function("s:InsertLines"))
+endfunc
+
+function! handlers#buffers#DeleteLines(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "DeleteLines")
+        return
+    endif
+    let body = a:envelope["body"]
+
+    function! s:DeleteLines(bufnr) closure
+        let range = s:Range(a:bufnr, body)
+        if empty(range)
+            return "INVALID_RANGE"
+        endif
+        call deletebufline(a:bufnr, range[0], range[1])
+        return "APPLIED"
+    endfunc
+
+    call s:Edit(a:channel, a:envelope, function("s:DeleteLines"))
+endfunc
+
+" listener ids of watched buffers keyed by buffer number.
+let s:listeners = {}
+
+" s:BufferChanged is the listener_add() callback of watched buffers.
+"
+" The changes Vim reports in a single callback are merged into one
+" BufferChange and broadcast on the buffer event mailboxes. A buffer
+" always uses the same mailbox so its changes are received in order.
+function! s:BufferChanged(bufnr, start, end, added, changes)
+    if ch_status(g:vgrpc_channel) != "open"
+        return
+    endif
+    let envelope = {
+                \ "mailbox": 4 + a:bufnr % 4,
+                \ "rpc": "BufferChanged",
+                \ "body": {
+                \   "bufnr": a:bufnr,
+                \   "start": a:start,
+                \   "end": a:end,
+                \   "added": a:added,
+                \   "lines": getbufline(a:bufnr, a:start, a:end - 1 + a:added),
+                \   "changedTick": getbufvar(a:bufnr, "changedtick")
+                \ }
+                \}
+    call ch_sendexpr(g:vgrpc_channel, envelope)
+endfunc
+
+" handlers#buffers#Reset stops watching every buffer, the watchers'
+" streams fail when the channel closes.
+function! handlers#buffers#Reset()
+    for id in values(s:listeners)
+        call listener_remove(id)
+    endfor
+    let s:listeners = {}
+endfunc
+
+function! handlers#buffers#WatchBuffer(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "WatchBuffer")
+        return
+    endif
+    let body = a:envelope["body"]
+    let bufnr = rpc#Int(body, "bufnr")
+
+    if !bufexists(bufnr)
+        call rpc#error#Reply(a:channel, a:envelope, "NOT_FOUND", "buffer " . bufnr . " not found")
+        return
+    endif
+    call bufload(bufnr)
+
+    if !has_key(s:listeners, bufnr)
+        let s:listeners[bufnr] = listener_add(function("s:BufferChanged"), bufnr)
+    endif
+
+    let a:envelope["body"] = {
+                \ "bufnr": bufnr,
+                \ "lines": get(body, "snapshot", v:false) ? getbufline(bufnr, 1, "$") : [],
+                \ "changedTick": getbufvar(bufnr, "changedtick")
+                \}
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
+
+function! handlers#buffers#UnwatchBuffer(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "UnwatchBuffer")
+        return
+    endif
+    let bufnr = rpc#Int(a:envelope["body"], "bufnr")
+
+    if has_key(s:listeners, bufnr)
+        call listener_remove(remove(s:listeners, bufnr))
+    endif
+
+    let a:envelope["body"] = {}
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
@@ -0,0 +1,11 @@
+" handlers#channel#EnvelopeRejected reports an envelope the proxy
+" quarantined, a handler sent a malformed or misaddressed message.
+function! handlers#channel#EnvelopeRejected(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "EnvelopeRejected")
+        return
+    endif
+    let body = a:envelope["body"]
+    echohl ErrorMsg
+    echomsg "vim-grpc: proxy rejected " . string(get(body, "rpc", "")) . " envelope for mailbox " . get(body, "mailbox", 0) . ": " . get(body, "error", "")
+    echohl None
+endfunc
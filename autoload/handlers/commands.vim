@@ -1,25 +1,199 @@
+" RegisterCommandRequest bodies of the registered commands keyed by
+" command name.
+let s:commands = {}
+
+" s:Attributes builds the :command attributes of a RegisterCommandRequest.
+function! s:Attributes(body)
+    let attrs = []
+    if has_key(a:body, "nargs")
+        call add(attrs, "-nargs=" . a:body["nargs"])
+    endif
+    let range = get(a:body, "range", "")
+    if range == "."
+        call add(attrs, "-range")
+    elseif range != ""
+        call add(attrs, "-range=" . range)
+    endif
+    if has_key(a:body, "count")
+        call add(attrs, "-count=" . a:body["count"])
+    endif
+    if get(a:body, "bang", v:false)
+        call add(attrs, "-bang")
+    endif
+    if get(a:body, "buffer", v:false)
+        call add(attrs, "-buffer")
+    endif
+    if has_key(a:body, "complete")
+        call add(attrs, "-complete=" . a:body["complete"])
+    endif
+    return join(attrs, " ")
+endfunc
+
+" s:Owner returns the id of the registered command titled a:title, an
+" empty string if there is none.
+function! s:Owner(title)
+    for [id, body] in items(s:commands)
+        if body["title"] == a:title
+            return id
+        endif
+    endfor
+    return ""
+endfunc
+
 function! handlers#commands#RegisterCommand(channel, envelope) abort
     if !rpc#validate#Name(a:envelope, "RegisterCommand")
         return
     endif
-    let title = a:envelope["body"]["title"]
-    let cmd = a:envelope["body"]["command"]
+    let body = a:envelope["body"]
+    let title = body["title"]
+    let cmd = body["command"]
 
-    exec 'command! ' . title . ' ' . 'call DoCommand("' . cmd . '")'
+    let define = printf('command! %s %s call DoCommand(%s, %s, <q-args>, [<f-args>], <line1>, <line2>, <range>, <count>, "<bang>")',
+                \ s:Attributes(body), title, string(cmd), string(title))
 
-    let registration = {
-                \ "registered": v:true,
-                \ "reason": ""
-                \}
-    let a:envelope["body"] =  registration
+    let registration = { "registered": v:true, "reason": "" }
+    let owner = s:Owner(title)
+    " the proxy replaces the commands of extensions which went away.
+    if get(body, "conflict", "REJECT") != "REPLACE"
+                \ && ((owner == "" && exists(":" . title) == 2) || (owner != "" && owner != cmd))
+        let a:envelope["body"] = { "registered": v:false, "reason": "command " . title . " already exists", "conflict": v:true }
+        call ch_sendexpr(a:channel, a:envelope)
+        return
+    endif
+    try
+        let bufnr = str2nr(get(body, "bufnr", "0"))
+        if bufnr == 0 || bufnr == bufnr()
+            exec define
+        elseif bufwinid(bufnr) != -1
+            call win_execute(bufwinid(bufnr), define)
+        else
+            let registration = { "registered": v:false, "reason": "buffer " . bufnr . " is not displayed in a window" }
+        endif
+    catch
+        let registration = { "registered": v:false, "reason": v:exception }
+    endtry
+    if registration["registered"]
+        " the proxy closes the replaced registration.
+        if owner != "" && owner != cmd
+            call remove(s:commands, owner)
+        endif
+        let s:commands[cmd] = body
+    endif
+
+    let a:envelope["body"] = registration
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
+
+" s:Delete deletes the command of a RegisterCommandRequest body.
+function! s:Delete(body)
+    " the title may have been taken over by another command.
+    let owner = s:Owner(a:body["title"])
+    if owner != "" && owner != a:body["command"]
+        return
+    endif
+    let delete = "delcommand " . (get(a:body, "buffer", v:false) ? "-buffer " : "") . a:body["title"]
+    let bufnr = str2nr(get(a:body, "bufnr", "0"))
+    " the command may already be gone, for instance with its buffer.
+    if bufnr == 0 || bufnr == bufnr()
+        silent! exec delete
+    elseif bufwinid(bufnr) != -1
+        silent! call win_execute(bufwinid(bufnr), delete)
+    endif
+    " the command may be registered again under another title.
+    if get(get(s:commands, a:body["command"], {}), "title", "") == a:body["title"]
+        call remove(s:commands, a:body["command"])
+    endif
+endfunc
+
+" handlers#commands#Reset deletes every registered command, the proxy
+" registers them again when the channel reconnects.
+function! handlers#commands#Reset()
+    for body in values(s:commands)
+        call s:Delete(body)
+    endfor
+endfunc
+
+function! handlers#commands#UnregisterCommand(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "UnregisterCommand")
+        return
+    endif
+
+    call s:Delete(a:envelope["body"])
+
+    let a:envelope["body"] = {}
     call ch_sendexpr(a:channel, a:envelope)
 endfunc
 
-function! DoCommand(command)
+function! handlers#commands#ExecCommand(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "ExecCommand")
+        return
+    endif
+    let body = a:envelope["body"]
+
+    let cmdline = get(body, "range", "") . body["command"] . (get(body, "bang", v:false) ? "!" : "") . " " . get(body, "args", "")
+    try
+        exec cmdline
+    catch
+        call rpc#error#ReplyFailed(a:channel, a:envelope, "INVALID_ARGUMENT",
+                    \ "executing " . body["command"] . " failed", rpc#error#FromException())
+        return
+    endtry
+    let a:envelope["body"] = {}
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
+
+" s:Show displays the text of a CommandResult.
+function! s:Show(text, display)
+    let lines = split(a:text, "\n")
+    if a:display == "POPUP"
+        call popup_atcursor(lines, { "border": [], "padding": [0, 1, 0, 1] })
+    elseif a:display == "SCRATCH"
+        new
+        setlocal buftype=nofile bufhidden=wipe noswapfile
+        call setline(1, lines)
+    else
+        echo a:text
+    endif
+endfunc
+
+" s:Result shows the CommandResult of an invocation of a:title.
+function! s:Result(title, channel, envelope)
+    let body = get(a:envelope, "body", {})
+    if has_key(body, "error")
+        echohl ErrorMsg
+        echomsg a:title . ": " . body["error"]
+        echohl None
+    elseif get(body, "text", "") != ""
+        call s:Show(body["text"], get(body, "display", "ECHO"))
+    endif
+endfunc
+
+function! DoCommand(command, title, qargs, fargs, line1, line2, range, count, bang)
+    if ch_status(g:vgrpc_channel) != "open"
+        echohl ErrorMsg
+        echomsg a:title . ": vim-grpc is not connected"
+        echohl None
+        return
+    endif
+    let pos = getcurpos()
     let envelope = {
                 \ "mailbox": 0,
                 \ "rpc": "CommandIssued",
-                \ "body": { "command": a:command }
+                \ "body": {
+                \   "command": a:command,
+                \   "title": a:title,
+                \   "args": a:fargs,
+                \   "rawArgs": a:qargs,
+                \   "line1": a:line1,
+                \   "line2": a:line2,
+                \   "range": a:range,
+                \   "count": a:count,
+                \   "bang": a:bang == "!" ? v:true : v:false,
+                \   "bufnr": bufnr(),
+                \   "filetype": &filetype,
+                \   "lnum": pos[1],
+                \   "col": pos[2]
+                \ }
                 \}
-    call ch_sendexpr(g:vgrpc_channel, envelope)
+    call ch_sendexpr(g:vgrpc_channel, envelope, { "callback": function("s:Result", [a:title]) })
 endfunc
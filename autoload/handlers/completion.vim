@@ -0,0 +1,108 @@
+" completion timeout in milliseconds keyed by filetype.
+let s:timeouts = {}
+
+" handlers#completion#Complete is the omnifunc of buffers whose filetype
+" has a registered completion source.
+"
+" Candidates are requested from the proxy on the completion mailboxes,
+" an empty list is returned if the proxy does not answer in time.
+function! handlers#completion#Complete(findstart, base)
+    if a:findstart
+        let line = getline(".")
+        let start = col(".") - 1
+        while start > 0 && line[start - 1] =~ '\k'
+            let start -= 1
+        endwhile
+        return start
+    endif
+
+    if ch_status(g:vgrpc_channel) != "open"
+        return []
+    endif
+
+    let envelope = {
+                \ "mailbox": 16 + bufnr() % 4,
+                \ "rpc": "CompletionRequested",
+                \ "body": {
+                \   "bufnr": bufnr(),
+                \   "filetype": &filetype,
+                \   "lnum": line("."),
+                \   "col": col("."),
+                \   "base": a:base,
+                \   "line": getline(".")
+                \ }
+                \}
+    " leave the proxy time to reply after its own timeout.
+    let timeout = get(s:timeouts, &filetype, 500) + 100
+    let reply = ch_evalexpr(g:vgrpc_channel, envelope, { "timeout": timeout })
+    if type(reply) != v:t_dict
+        return []
+    endif
+
+    let items = []
+    for item in get(reply["body"], "items", [])
+        call add(items, {
+                    \ "word": get(item, "word", ""),
+                    \ "abbr": get(item, "abbr", ""),
+                    \ "kind": get(item, "kind", ""),
+                    \ "menu": get(item, "menu", ""),
+                    \ "info": get(item, "info", ""),
+                    \ "dup": 1
+                    \})
+    endfor
+    return items
+endfunc
+
+" s:SetOmnifunc sets or, when a:set is false, resets the omnifunc of
+" every buffer with one of the provided filetypes.
+function! s:SetOmnifunc(filetypes, set)
+    for info in getbufinfo()
+        if index(a:filetypes, getbufvar(info["bufnr"], "&filetype")) == -1
+            continue
+        endif
+        if a:set
+            call setbufvar(info["bufnr"], "&omnifunc", "handlers#completion#Complete")
+        elseif getbufvar(info["bufnr"], "&omnifunc") == "handlers#completion#Complete"
+            call setbufvar(info["bufnr"], "&omnifunc", "")
+        endif
+    endfor
+endfunc
+
+function! handlers#completion#RegisterCompletion(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "RegisterCompletion")
+        return
+    endif
+    let body = a:envelope["body"]
+    let filetypes = body["filetypes"]
+    let timeout = str2nr(get(body, "timeoutMs", "500"))
+
+    augroup vgrpc_completion
+        for ft in filetypes
+            let s:timeouts[ft] = max([get(s:timeouts, ft, 0), timeout])
+            exec "autocmd! FileType " . ft
+            exec "autocmd FileType " . ft . " setlocal omnifunc=handlers#completion#Complete"
+        endfor
+    augroup END
+    call s:SetOmnifunc(filetypes, v:true)
+
+    let a:envelope["body"] = { "registered": v:true, "reason": "" }
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
+
+function! handlers#completion#UnregisterCompletion(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "UnregisterCompletion")
+        return
+    endif
+    let filetypes = a:envelope["body"]["filetypes"]
+
+    for ft in filetypes
+        if has_key(s:timeouts, ft)
+            call remove(s:timeouts, ft)
+        endif
+        exec "autocmd! vgrpc_completion FileType " . ft
+    endfor
+    call s:SetOmnifunc(filetypes, v:false)
+
+    let a:envelope["body"] = { "registered": v:false, "reason": "" }
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
@@ -0,0 +1,202 @@
+" published diagnostics keyed by buffer number and source.
+let s:diagnostics = {}
+" text property id of each source.
+let s:source_ids = {}
+
+let s:severities = {
+            \ "ERROR": { "name": "Error", "sign": "E>", "priority": 14 },
+            \ "WARNING": { "name": "Warning", "sign": "W>", "priority": 13 },
+            \ "INFO": { "name": "Info", "sign": "I>", "priority": 12 },
+            \ "HINT": { "name": "Hint", "sign": "H>", "priority": 11 }
+            \}
+
+highlight default link VGRPCDiagnosticErrorSign ErrorMsg
+highlight default link VGRPCDiagnosticWarningSign WarningMsg
+highlight default link VGRPCDiagnosticInfoSign Question
+highlight default link VGRPCDiagnosticHintSign Comment
+highlight default link VGRPCDiagnosticError SpellBad
+highlight default link VGRPCDiagnosticWarning SpellCap
+highlight default link VGRPCDiagnosticInfo SpellRare
+highlight default link VGRPCDiagnosticHint SpellLocal
+
+for s:severity in values(s:severities)
+    call sign_define("VGRPCDiagnostic" . s:severity["name"], {
+                \ "text": s:severity["sign"],
+                \ "texthl": "VGRPCDiagnostic" . s:severity["name"] . "Sign"
+                \})
+    if empty(prop_type_get("VGRPCDiagnostic" . s:severity["name"]))
+        call prop_type_add("VGRPCDiagnostic" . s:severity["name"], {
+                    \ "highlight": "VGRPCDiagnostic" . s:severity["name"],
+                    \ "priority": s:severity["priority"]
+                    \})
+    endif
+endfor
+
+augroup vgrpc_diagnostics
+    autocmd!
+    autocmd BufWipeout * call s:Forget(str2nr(expand("<abuf>")))
+augroup END
+
+" s:Forget drops the diagnostics of a wiped out buffer.
+function! s:Forget(bufnr)
+    if has_key(s:diagnostics, a:bufnr)
+        call remove(s:diagnostics, a:bufnr)
+    endif
+endfunc
+
+" s:SourceId returns the text property id of a source.
+function! s:SourceId(source)
+    if !has_key(s:source_ids, a:source)
+        let s:source_ids[a:source] = len(s:source_ids) + 1
+    endif
+    return s:source_ids[a:source]
+endfunc
+
+" s:Clear removes the signs and text properties of a source from a buffer.
+function! s:Clear(bufnr, source)
+    call sign_unplace("vgrpc_diagnostics_" . a:source, { "buffer": a:bufnr })
+    for severity in values(s:severities)
+        call prop_remove({
+                    \ "id": s:SourceId(a:source),
+                    \ "type": "VGRPCDiagnostic" . severity["name"],
+                    \ "bufnr": a:bufnr,
+                    \ "both": v:true,
+                    \ "all": v:true
+                    \})
+    endfor
+endfunc
+
+" s:Plan validates a source's diagnostics and returns the signs and text
+" properties rendering them, nothing is placed until s:Apply.
+" Diagnostics outside of the buffer are not rendered, a diagnostic's
+" columns outside of its lines are not highlighted but still signed.
+function! s:Plan(bufnr, source, diagnostics)
+    let line_count = len(getbufline(a:bufnr, 1, "$"))
+    let plan = { "signs": [], "props": [] }
+    for diag in a:diagnostics
+        let severity = s:severities[get(diag, "severity", "ERROR")]
+        let range = diag["range"]
+        let start_lnum = rpc#Int(range, "startLnum")
+        let end_lnum = max([rpc#Int(range, "endLnum"), start_lnum])
+        if start_lnum > line_count
+            continue
+        endif
+        let end_lnum = min([end_lnum, line_count])
+
+        call add(plan["signs"], {
+                    \ "buffer": a:bufnr,
+                    \ "group": "vgrpc_diagnostics_" . a:source,
+                    \ "lnum": start_lnum,
+                    \ "name": "VGRPCDiagnostic" . severity["name"],
+                    \ "priority": severity["priority"]
+                    \})
+
+        let start_col = max([rpc#Int(range, "startCol"), 1])
+        let end_len = len(getbufline(a:bufnr, end_lnum)[0])
+        let end_col = rpc#Int(range, "endCol")
+        if end_col == 0
+            let end_col = end_len + 1
+        endif
+        if start_col > len(getbufline(a:bufnr, start_lnum)[0]) + 1 || end_col > end_len + 1
+                    \ || (start_lnum == end_lnum && end_col < start_col)
+            continue
+        endif
+        call add(plan["props"], [start_lnum, start_col, {
+                    \ "end_lnum": end_lnum,
+                    \ "end_col": end_col,
+                    \ "type": "VGRPCDiagnostic" . severity["name"],
+                    \ "id": s:SourceId(a:source),
+                    \ "bufnr": a:bufnr
+                    \}])
+    endfor
+    return plan
+endfunc
+
+" s:Apply places the signs and text properties of a plan.
+function! s:Apply(plan)
+    for [lnum, col, props] in a:plan["props"]
+        call prop_add(lnum, col, props)
+    endfor
+    call sign_placelist(a:plan["signs"])
+endfunc
+
+" s:Replace replaces the rendered diagnostics of a source. If they cannot
+" be placed the previous diagnostics are restored and the exception is
+" returned as a VimError, an empty dict otherwise.
+function! s:Replace(bufnr, source, diagnostics)
+    try
+        call bufload(a:bufnr)
+        let plan = s:Plan(a:bufnr, a:source, a:diagnostics)
+    catch
+        return rpc#error#FromException()
+    endtry
+
+    call s:Clear(a:bufnr, a:source)
+    try
+        call s:Apply(plan)
+    catch
+        let error = rpc#error#FromException()
+        call s:Clear(a:bufnr, a:source)
+        call s:Apply(s:Plan(a:bufnr, a:source, get(get(s:diagnostics, a:bufnr, {}), a:source, [])))
+        return error
+    endtry
+
+    if empty(a:diagnostics)
+        if has_key(get(s:diagnostics, a:bufnr, {}), a:source)
+            call remove(s:diagnostics[a:bufnr], a:source)
+        endif
+    else
+        let s:diagnostics[a:bufnr] = get(s:diagnostics, a:bufnr, {})
+        let s:diagnostics[a:bufnr][a:source] = a:diagnostics
+    endif
+    return {}
+endfunc
+
+function! handlers#diagnostics#PublishDiagnostics(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "PublishDiagnostics")
+        return
+    endif
+    let body = a:envelope["body"]
+    let bufnr = rpc#Int(body, "bufnr")
+    let source = body["source"]
+    let diagnostics = get(body, "diagnostics", [])
+
+    if !bufexists(bufnr)
+        let a:envelope["body"] = { "found": v:false }
+        call ch_sendexpr(a:channel, a:envelope)
+        return
+    endif
+
+    let error = s:Replace(bufnr, source, diagnostics)
+    if !empty(error)
+        call rpc#error#ReplyFailed(a:channel, a:envelope, "INVALID_ARGUMENT", "PublishDiagnostics failed", error)
+        return
+    endif
+    let a:envelope["body"] = { "found": v:true }
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
+
+function! handlers#diagnostics#ListDiagnostics(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "ListDiagnostics")
+        return
+    endif
+    let body = a:envelope["body"]
+    let bufnr = rpc#Int(body, "bufnr")
+    let source = get(body, "source", "")
+
+    let sets = []
+    for [b, sources] in items(s:diagnostics)
+        if bufnr != 0 && bufnr != str2nr(b)
+            continue
+        endif
+        for [s, diagnostics] in items(sources)
+            if source != "" && source != s
+                continue
+            endif
+            call add(sets, { "source": s, "bufnr": str2nr(b), "diagnostics": diagnostics })
+        endfor
+    endfor
+
+    let a:envelope["body"] = { "sets": sets }
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
@@ -0,0 +1,67 @@
+let s:types = ["number", "string", "funcref", "list", "dict", "float", "bool", "special", "job", "channel", "blob"]
+
+" s:Finite reports whether a value holds no inf or nan float, which Vim
+" encodes as Infinity and NaN, invalid JSON the proxy would fail to decode.
+function! s:Finite(value)
+    if type(a:value) == v:t_float
+        return !isinf(a:value) && !isnan(a:value)
+    elseif type(a:value) == v:t_list
+        return empty(filter(copy(a:value), '!s:Finite(v:val)'))
+    elseif type(a:value) == v:t_dict
+        return empty(filter(values(a:value), '!s:Finite(v:val)'))
+    endif
+    return v:true
+endfunc
+
+function! handlers#editor#Execute(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "Execute")
+        return
+    endif
+    let body = a:envelope["body"]
+    let silent = get(body, "silent", v:false) ? "silent" : ""
+
+    let result = { "output": "" }
+    let completed = 0
+    for cmd in get(body, "commands", [])
+        try
+            let result["output"] .= execute(cmd, silent)
+        catch
+            call rpc#error#ReplyFailed(a:channel, a:envelope, "INVALID_ARGUMENT",
+                        \ printf("command %d (%s) failed", completed + 1, string(cmd)), rpc#error#FromException())
+            return
+        endtry
+        let completed += 1
+    endfor
+
+    let a:envelope["body"] = result
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
+
+function! handlers#editor#Eval(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "Eval")
+        return
+    endif
+
+    let expr = a:envelope["body"]["expr"]
+    try
+        let Value = eval(expr)
+    catch
+        call rpc#error#ReplyFailed(a:channel, a:envelope, "INVALID_ARGUMENT",
+                    \ "evaluating " . string(expr) . " failed", rpc#error#FromException())
+        return
+    endtry
+
+    let type = get(s:types, type(Value), "unknown")
+    " values which cannot be encoded as JSON are returned as their string().
+    try
+        call json_encode(Value)
+        if !s:Finite(Value)
+            let Value = string(Value)
+        endif
+    catch
+        let Value = string(Value)
+    endtry
+
+    let a:envelope["body"] = { "value": Value, "type": type }
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
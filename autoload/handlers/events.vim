@@ -0,0 +1,55 @@
+" handlers#events#Fire is called by the autocommands installed in the
+" vgrpc_events group and broadcasts the event on the event mailboxes.
+" A buffer always uses the same mailbox so its events are received in order.
+function! handlers#events#Fire(event)
+    if ch_status(g:vgrpc_channel) != "open"
+        return
+    endif
+    let bufnr = str2nr(expand("<abuf>"))
+    let envelope = {
+                \ "mailbox": 8 + bufnr % 4,
+                \ "rpc": "AutocmdFired",
+                \ "body": {
+                \   "event": a:event,
+                \   "bufnr": bufnr,
+                \   "file": expand("<afile>:p"),
+                \   "match": expand("<amatch>"),
+                \   "filetype": bufnr > 0 ? getbufvar(bufnr, "&filetype") : ""
+                \ }
+                \}
+    call ch_sendexpr(g:vgrpc_channel, envelope)
+endfunc
+
+function! handlers#events#EnableAutocmds(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "EnableAutocmds")
+        return
+    endif
+    let events = get(a:envelope["body"], "events", [])
+
+    let unknown = filter(copy(events), {_, event -> !exists("##" . event)})
+    if empty(unknown)
+        augroup vgrpc_events
+            for event in events
+                exec "autocmd! " . event . " *"
+                exec "autocmd " . event . " * call handlers#events#Fire('" . event . "')"
+            endfor
+        augroup END
+    endif
+
+    let a:envelope["body"] = { "unknown": unknown }
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
+
+function! handlers#events#DisableAutocmds(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "DisableAutocmds")
+        return
+    endif
+    for event in get(a:envelope["body"], "events", [])
+        if exists("##" . event)
+            exec "autocmd! vgrpc_events " . event
+        endif
+    endfor
+
+    let a:envelope["body"] = {}
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
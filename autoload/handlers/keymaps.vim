@@ -0,0 +1,133 @@
+" KeymapDefinition bodies of the registered mappings keyed by id.
+let s:keymaps = {}
+
+" :map command prefix keyed by Mode.
+let s:modes = { "NORMAL": "n", "VISUAL": "x", "INSERT": "i", "OPERATOR_PENDING": "o" }
+
+" s:InBuffer executes a:cmd in the buffer of a RegisterKeymapRequest body,
+" returns an error message if the buffer is not displayed.
+function! s:InBuffer(keymap, cmd)
+    let bufnr = rpc#Int(a:keymap, "bufnr")
+    if bufnr == 0 || bufnr == bufnr()
+        exec a:cmd
+    elseif bufwinid(bufnr) != -1
+        call win_execute(bufwinid(bufnr), a:cmd)
+    else
+        return "buffer " . bufnr . " is not displayed in a window"
+    endif
+    return ""
+endfunc
+
+" handlers#keymaps#Trigger is the rhs of the registered mappings, it
+" broadcasts a KeymapTriggered on the keymap mailboxes.
+function! handlers#keymaps#Trigger(id, mode)
+    if ch_status(g:vgrpc_channel) != "open" || !has_key(s:keymaps, a:id)
+        return
+    endif
+    let keymap = s:keymaps[a:id]["keymap"]
+    let pos = getcurpos()
+    let body = {
+                \ "id": a:id,
+                \ "lhs": keymap["lhs"],
+                \ "mode": a:mode,
+                \ "count": v:count,
+                \ "register": v:register,
+                \ "bufnr": bufnr(),
+                \ "lnum": pos[1],
+                \ "col": pos[2]
+                \ }
+    if a:mode == "VISUAL"
+        let [start, end] = [getpos("v"), getpos(".")]
+        if start[1] > end[1] || (start[1] == end[1] && start[2] > end[2])
+            let [start, end] = [end, start]
+        endif
+        let body["selection"] = {
+                    \ "startLnum": start[1],
+                    \ "startCol": start[2],
+                    \ "endLnum": end[1],
+                    \ "endCol": end[2],
+                    \ "type": mode()
+                    \ }
+        call feedkeys("\<Esc>", "n")
+    elseif a:mode == "OPERATOR_PENDING"
+        let body["operator"] = v:operator
+        call feedkeys("\<Esc>", "n")
+    endif
+
+    let envelope = {
+                \ "mailbox": 20 + a:id % 4,
+                \ "rpc": "KeymapTriggered",
+                \ "body": body
+                \}
+    call ch_sendexpr(g:vgrpc_channel, envelope)
+endfunc
+
+" s:Unmap removes the mappings of a KeymapDefinition body in a:modes.
+function! s:Unmap(definition, modes)
+    let keymap = a:definition["keymap"]
+    let buffer = get(keymap, "buffer", v:false) ? "<buffer> " : ""
+    for mode in a:modes
+        " the mapping may already be gone, for instance with its buffer.
+        silent! call s:InBuffer(keymap, s:modes[mode] . "unmap " . buffer . keymap["lhs"])
+    endfor
+    let id = rpc#Int(a:definition, "id")
+    if has_key(s:keymaps, id)
+        call remove(s:keymaps, id)
+    endif
+endfunc
+
+" handlers#keymaps#Reset removes every registered mapping, the proxy
+" registers them again when the channel reconnects.
+function! handlers#keymaps#Reset()
+    for definition in values(s:keymaps)
+        call s:Unmap(definition, definition["keymap"]["modes"])
+    endfor
+endfunc
+
+function! handlers#keymaps#RegisterKeymap(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "RegisterKeymap")
+        return
+    endif
+    let definition = a:envelope["body"]
+    let keymap = definition["keymap"]
+    let id = rpc#Int(definition, "id")
+    let buffer = get(keymap, "buffer", v:false) ? "<buffer> " : ""
+
+    let registration = { "registered": v:true, "reason": "" }
+    let mapped = []
+    try
+        for mode in keymap["modes"]
+            " <unique> leaves the user's own mappings alone.
+            let map = printf('%snoremap <silent> <unique> %s%s <Cmd>call handlers#keymaps#Trigger(%d, "%s")<CR>',
+                        \ s:modes[mode], buffer, keymap["lhs"], id, mode)
+            let reason = s:InBuffer(keymap, map)
+            if reason != ""
+                let registration = { "registered": v:false, "reason": reason }
+                break
+            endif
+            call add(mapped, mode)
+        endfor
+    catch
+        let registration = { "registered": v:false, "reason": v:exception }
+    endtry
+    if registration["registered"]
+        let s:keymaps[id] = definition
+    else
+        call s:Unmap(definition, mapped)
+    endif
+
+    let a:envelope["body"] = registration
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
+
+function! handlers#keymaps#UnregisterKeymap(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "UnregisterKeymap")
+        return
+    endif
+
+    let definition = a:envelope["body"]
+    call s:Unmap(definition, definition["keymap"]["modes"])
+
+    let a:envelope["body"] = { "registered": v:false, "reason": "" }
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
@@ -0,0 +1,152 @@
+" s:Item converts an Item message into a setqflist() item.
+function! s:Item(item)
+    let item = {
+                \ "lnum": rpc#Int(a:item, "lnum"),
+                \ "col": rpc#Int(a:item, "col"),
+                \ "end_lnum": rpc#Int(a:item, "endLnum"),
+                \ "end_col": rpc#Int(a:item, "endCol"),
+                \ "type": get(a:item, "type", ""),
+                \ "text": get(a:item, "text", "")
+                \}
+    if has_key(a:item, "bufnr")
+        let item["bufnr"] = rpc#Int(a:item, "bufnr")
+    else
+        let item["filename"] = a:item["filename"]
+    endif
+    return item
+endfunc
+
+" s:ToItem converts a getqflist() item into an Item message.
+function! s:ToItem(item)
+    return {
+                \ "filename": a:item["bufnr"] > 0 ? fnamemodify(bufname(a:item["bufnr"]), ":p") : "",
+                \ "bufnr": a:item["bufnr"],
+                \ "lnum": a:item["lnum"],
+                \ "col": a:item["col"],
+                \ "endLnum": get(a:item, "end_lnum", 0),
+                \ "endCol": get(a:item, "end_col", 0),
+                \ "type": a:item["type"],
+                \ "text": a:item["text"],
+                \ "valid": a:item["valid"] ? v:true : v:false
+                \}
+endfunc
+
+" s:List calls a:Func with the request's winid, zero for the quickfix
+" list, and replies with a ListResult.
+"
+" a:Func returns the ListResult fields to reply with. Exceptions raised
+" by a:Func fail the request, see rpc#error#ReplyFailed.
+function! s:List(channel, envelope, Func)
+    let winid = rpc#Int(a:envelope["body"], "winid")
+    if winid > 0 && empty(getwininfo(winid))
+        let a:envelope["body"] = { "found": v:false }
+        call ch_sendexpr(a:channel, a:envelope)
+        return
+    endif
+
+    try
+        let result = a:Func(winid)
+    catch
+        call rpc#error#ReplyFailed(a:channel, a:envelope, "FAILED_PRECONDITION",
+                    \ a:envelope["rpc"] . " failed", rpc#error#FromException())
+        return
+    endtry
+    let result["found"] = v:true
+
+    let a:envelope["body"] = result
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
+
+function! handlers#quickfix#SetList(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "SetList")
+        return
+    endif
+    let body = a:envelope["body"]
+
+    function! s:SetList(winid) closure
+        let what = { "items": map(copy(get(body, "items", [])), {_, item -> s:Item(item)}) }
+        if has_key(body, "title")
+            let what["title"] = body["title"]
+        endif
+        if has_key(body, "context")
+            let what["context"] = body["context"]
+        endif
+        let action = get(body, "action", "REPLACE") == "APPEND" ? "a" : "r"
+        if a:winid == 0
+            call setqflist([], action, what)
+            return { "id": getqflist({ "id": 0 })["id"] }
+        endif
+        call setloclist(a:winid, [], action, what)
+        return { "id": getloclist(a:winid, { "id": 0 })["id"] }
+    endfunc
+
+    call s:List(a:channel, a:envelope, function("s:SetList"))
+endfunc
+
+function! handlers#quickfix#GetList(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "GetList")
+        return
+    endif
+
+    function! s:GetList(winid)
+        let what = { "id": 0, "title": 0, "context": 0, "items": 0 }
+        let list = a:winid == 0 ? getqflist(what) : getloclist(a:winid, what)
+        let response = {
+                    \ "id": get(list, "id", 0),
+                    \ "title": get(list, "title", ""),
+                    \ "items": map(get(list, "items", []), {_, item -> s:ToItem(item)})
+                    \}
+        " the context of a list without one is an empty string.
+        if get(list, "context", "") isnot ""
+            let response["context"] = list["context"]
+        endif
+        return { "list": response }
+    endfunc
+
+    call s:List(a:channel, a:envelope, function("s:GetList"))
+endfunc
+
+function! handlers#quickfix#OpenList(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "OpenList")
+        return
+    endif
+    let height = rpc#Int(a:envelope["body"], "height")
+
+    function! s:OpenList(winid) closure
+        let prev = win_getid()
+        try
+            if a:winid != 0
+                call win_gotoid(a:winid)
+            endif
+            exec (a:winid == 0 ? "copen" : "lopen") . (height > 0 ? " " . height : "")
+            return { "id": win_getid() }
+        finally
+            call win_gotoid(prev)
+        endtry
+    endfunc
+
+    call s:List(a:channel, a:envelope, function("s:OpenList"))
+endfunc
+
+function! handlers#quickfix#CloseList(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "CloseList")
+        return
+    endif
+
+    function! s:CloseList(winid)
+        if a:winid == 0
+            cclose
+            return {}
+        endif
+        let prev = win_getid()
+        try
+            call win_gotoid(a:winid)
+            lclose
+        finally
+            call win_gotoid(prev)
+        endtry
+        return {}
+    endfunc
+
+    call s:List(a:channel, a:envelope, function("s:CloseList"))
+endfunc
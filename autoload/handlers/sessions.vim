@@ -0,0 +1,32 @@
+" handlers#sessions#Id returns the id the proxy knows this Vim by,
+" g:vgrpc_session overrides the default of Vim's pid.
+function! handlers#sessions#Id()
+    return get(g:, "vgrpc_session", string(getpid()))
+endfunc
+
+" handlers#sessions#Focused broadcasts a SessionFocused on the session
+" mailboxes, calls without a session are routed to the focused Vim.
+function! handlers#sessions#Focused()
+    if ch_status(g:vgrpc_channel) != "open"
+        return
+    endif
+    let envelope = {
+                \ "mailbox": 24 + getpid() % 4,
+                \ "rpc": "SessionFocused",
+                \ "body": { "id": handlers#sessions#Id() }
+                \}
+    call ch_sendexpr(g:vgrpc_channel, envelope)
+endfunc
+
+function! handlers#sessions#Session(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "Session")
+        return
+    endif
+    let a:envelope["body"] = {
+                \ "id": handlers#sessions#Id(),
+                \ "pid": getpid(),
+                \ "cwd": getcwd(),
+                \ "servername": v:servername
+                \ }
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
@@ -0,0 +1,70 @@
+" s:Closed is the popup callback broadcasting a PopupCallback on the
+" popup mailboxes, bound to the popup's token with function().
+function! s:Closed(token, id, result)
+    if ch_status(g:vgrpc_channel) != "open"
+        return
+    endif
+    let envelope = {
+                \ "mailbox": 12 + a:token % 4,
+                \ "rpc": "PopupClosed",
+                \ "body": { "token": a:token, "popupId": a:id, "result": a:result }
+                \}
+    call ch_sendexpr(g:vgrpc_channel, envelope)
+endfunc
+
+function! handlers#ui#Notify(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "Notify")
+        return
+    endif
+    let body = a:envelope["body"]
+
+    let options = {}
+    if has_key(body, "title")
+        let options["title"] = body["title"]
+    endif
+    if has_key(body, "timeMs")
+        let options["time"] = rpc#Int(body, "timeMs")
+    endif
+    if has_key(body, "highlight")
+        let options["highlight"] = body["highlight"]
+    endif
+
+    let a:envelope["body"] = { "popupId": popup_notification(body["lines"], options) }
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
+
+function! handlers#ui#OpenPopup(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "OpenPopup")
+        return
+    endif
+    let body = a:envelope["body"]
+    let token = rpc#Int(body, "token")
+
+    if has_key(body, "menu")
+        let options = { "callback": function("s:Closed", [token]) }
+        if has_key(body["menu"], "title")
+            let options["title"] = body["menu"]["title"]
+        endif
+        let id = popup_menu(body["menu"]["items"], options)
+    else
+        let options = { "callback": function("s:Closed", [token]), "border": [], "padding": [0, 1, 0, 1] }
+        if has_key(body["info"], "title")
+            let options["title"] = body["info"]["title"]
+        endif
+        let id = popup_atcursor(body["info"]["lines"], options)
+    endif
+
+    let a:envelope["body"] = { "popupId": id }
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
+
+function! handlers#ui#ClosePopup(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "ClosePopup")
+        return
+    endif
+
+    call popup_close(rpc#Int(a:envelope["body"], "popupId"), -1)
+
+    let a:envelope["body"] = {}
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
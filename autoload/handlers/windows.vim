@@ -0,0 +1,194 @@
+" s:Winid resolves the winid of a request, zero refers to the current
+" window. Zero is returned if the window does not exist.
+function! s:Winid(body)
+    let winid = rpc#Int(a:body, "winid")
+    if winid == 0
+        return win_getid()
+    endif
+    return empty(getwininfo(winid)) ? 0 : winid
+endfunc
+
+" s:Window converts a winid into the Window message defined in
+" proto/windows/windows.proto.
+function! s:Window(winid)
+    let info = getwininfo(a:winid)[0]
+    let pos = getcurpos(a:winid)
+    return {
+                \ "winid": a:winid,
+                \ "winnr": info["winnr"],
+                \ "tabnr": info["tabnr"],
+                \ "bufnr": info["bufnr"],
+                \ "width": info["width"],
+                \ "height": info["height"],
+                \ "cursor": { "lnum": pos[1], "col": pos[2] },
+                \ "current": a:winid == win_getid() ? v:true : v:false
+                \}
+endfunc
+
+" s:Alter calls a:Func with the request's winid, while the window is
+" focused if a:focus is set, and replies with a WindowResult.
+"
+" Focus stays where a:Func leaves it if a:keep is set, otherwise the
+" window focused before the request is focused again if it still exists.
+"
+" a:Func returns the winid and bufnr to reply with. Exceptions raised
+" by a:Func fail the request, see rpc#error#ReplyFailed.
+function! s:Alter(channel, envelope, focus, keep, Func)
+    let winid = s:Winid(a:envelope["body"])
+    if winid == 0
+        let a:envelope["body"] = {}
+        call ch_sendexpr(a:channel, a:envelope)
+        return
+    endif
+
+    let prev = win_getid()
+    try
+        if a:focus
+            call win_gotoid(winid)
+        endif
+        let [winid, bufnr] = a:Func(winid)
+        let a:envelope["body"] = { "winid": winid, "bufnr": bufnr }
+        if !a:keep
+            call win_gotoid(prev)
+        endif
+    catch
+        call win_gotoid(prev)
+        call rpc#error#ReplyFailed(a:channel, a:envelope, "FAILED_PRECONDITION",
+                    \ a:envelope["rpc"] . " failed", rpc#error#FromException())
+        return
+    endtry
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
+
+function! handlers#windows#ListTabpages(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "ListTabpages")
+        return
+    endif
+
+    let tabpages = []
+    for info in gettabinfo()
+        call add(tabpages, {
+                    \ "tabnr": info["tabnr"],
+                    \ "windows": map(copy(info["windows"]), {_, winid -> s:Window(winid)}),
+                    \ "current": info["tabnr"] == tabpagenr() ? v:true : v:false
+                    \})
+    endfor
+
+    let a:envelope["body"] = { "tabpages": tabpages }
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
+
+function! handlers#windows#ListWindows(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "ListWindows")
+        return
+    endif
+    let tabnr = rpc#Int(a:envelope["body"], "tabnr")
+
+    let infos = tabnr == 0 ? getwininfo() : filter(getwininfo(), {_, info -> info["tabnr"] == tabnr})
+
+    let a:envelope["body"] = { "windows": map(infos, {_, info -> s:Window(info["winid"])}) }
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
+
+function! handlers#windows#GetCursor(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "GetCursor")
+        return
+    endif
+    let winid = s:Winid(a:envelope["body"])
+
+    if winid == 0
+        let a:envelope["body"] = {}
+    else
+        let pos = getcurpos(winid)
+        let a:envelope["body"] = {
+                    \ "winid": winid,
+                    \ "cursor": { "lnum": pos[1], "col": pos[2] }
+                    \}
+    endif
+    call ch_sendexpr(a:channel, a:envelope)
+endfunc
+
+function! handlers#windows#SetCursor(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "SetCursor")
+        return
+    endif
+    let cursor = a:envelope["body"]["cursor"]
+
+    function! s:SetCursor(winid) closure
+        call win_execute(a:winid, printf("call cursor(%d, %d)", rpc#Int(cursor, "lnum"), max([rpc#Int(cursor, "col"), 1])))
+        return [a:winid, winbufnr(a:winid)]
+    endfunc
+
+    call s:Alter(a:channel, a:envelope, v:false, v:false, function("s:SetCursor"))
+endfunc
+
+function! handlers#windows#SplitWindow(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "SplitWindow")
+        return
+    endif
+    let body = a:envelope["body"]
+
+    function! s:SplitWindow(winid) closure
+        let size = rpc#Int(body, "size")
+        let bufnr = rpc#Int(body, "bufnr")
+        exec (get(body, "vertical", v:false) ? "vertical " : "") . (size > 0 ? size : "") . "split"
+        if bufnr > 0
+            exec "buffer " . bufnr
+        endif
+        let new = win_getid()
+        return [new, winbufnr(new)]
+    endfunc
+
+    call s:Alter(a:channel, a:envelope, v:true, get(body, "focus", v:false), function("s:SplitWindow"))
+endfunc
+
+function! handlers#windows#CloseWindow(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "CloseWindow")
+        return
+    endif
+    let body = a:envelope["body"]
+
+    function! s:CloseWindow(winid) closure
+        let bufnr = winbufnr(a:winid)
+        exec "close" . (get(body, "force", v:false) ? "!" : "")
+        return [a:winid, bufnr]
+    endfunc
+
+    call s:Alter(a:channel, a:envelope, v:true, v:false, function("s:CloseWindow"))
+endfunc
+
+function! handlers#windows#FocusWindow(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "FocusWindow")
+        return
+    endif
+
+    function! s:FocusWindow(winid)
+        return [a:winid, winbufnr(a:winid)]
+    endfunc
+
+    call s:Alter(a:channel, a:envelope, v:true, v:true, function("s:FocusWindow"))
+endfunc
+
+function! handlers#windows#OpenFile(channel, envelope) abort
+    if !rpc#validate#Name(a:envelope, "OpenFile")
+        return
+    endif
+    let body = a:envelope["body"]
+
+    function! s:OpenFile(winid) closure
+        let cmds = {
+                    \ "NONE": "edit",
+                    \ "HORIZONTAL": "split",
+                    \ "VERTICAL": "vsplit",
+                    \ "TAB": "tabedit"
+                    \}
+        exec cmds[get(body, "split", "NONE")] . " " . fnameescape(body["path"])
+        let lnum = rpc#Int(body, "lnum")
+        if lnum > 0
+            call cursor(lnum, max([rpc#Int(body, "col"), 1]))
+        endif
+        return [win_getid(), bufnr()]
+    endfunc
+
+    call s:Alter(a:channel, a:envelope, v:true, v:true, function("s:OpenFile"))
+endfunc
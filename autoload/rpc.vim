@@ -0,0 +1,5 @@
+" rpc#Int reads an int64 field from a jsonpb encoded body, int64 values
+" are encoded as strings and omitted when zero.
+function! rpc#Int(body, key)
+    return str2nr(get(a:body, a:key, "0"))
+endfunc
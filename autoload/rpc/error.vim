@@ -0,0 +1,63 @@
+" status codes of the Vim errors a request's arguments may cause, other
+" Vim errors are replied with the code the handler chooses.
+let s:codes = {
+            \ "E16": "OUT_OF_RANGE",
+            \ "E86": "NOT_FOUND",
+            \ "E94": "NOT_FOUND",
+            \ "E957": "NOT_FOUND",
+            \ "E964": "OUT_OF_RANGE",
+            \ "E966": "OUT_OF_RANGE",
+            \}
+
+" rpc#error#FromException describes the exception being handled as a
+" VimError message. Must be called from a :catch clause.
+function! rpc#error#FromException()
+    return {
+                \ "code": matchstr(v:exception, '\<E\d\+\ze:'),
+                \ "message": substitute(v:exception, '^Vim\%((\a\+)\)\=:', '', ''),
+                \ "throwpoint": v:throwpoint
+                \}
+endfun
+
+" rpc#error#Reply answers a request with an error instead of a body.
+" a:code names a gRPC status code such as "NOT_FOUND", the proxy fails
+" the client's call with it.
+function! rpc#error#Reply(channel, envelope, code, message)
+    call s:Reply(a:channel, a:envelope, { "code": a:code, "message": a:message })
+endfun
+
+" rpc#error#ReplyException answers a request with the exception being
+" handled as an INTERNAL error. Must be called from a :catch clause.
+function! rpc#error#ReplyException(channel, envelope)
+    let exception = rpc#error#FromException()
+    call s:Reply(a:channel, a:envelope, {
+                \ "code": "INTERNAL",
+                \ "message": a:envelope["rpc"] . " handler failed: " . exception["message"],
+                \ "exception": exception
+                \})
+endfun
+
+" rpc#error#ReplyFailed answers a request with a:exception, see
+" rpc#error#FromException, raised by what the request asked Vim to do.
+" The status code is the one of the exception's Vim error, a:code if it
+" has none. a:message prefixes the exception's message.
+function! rpc#error#ReplyFailed(channel, envelope, code, message, exception)
+    call s:Reply(a:channel, a:envelope, {
+                \ "code": get(s:codes, a:exception["code"], a:code),
+                \ "message": a:message . ": " . a:exception["message"],
+                \ "exception": a:exception
+                \})
+endfun
+
+" Only requests are answered, their correlation IDs are never below 28,
+" the broadcast mailboxes. Anything else, such as an EnvelopeRejected
+" rpc under mailbox 0, awaits no response: the proxy would quarantine
+" the reply and reject it in turn, answering rejections without end.
+function! s:Reply(channel, envelope, error)
+    if get(a:envelope, "mailbox", 0) < 28
+        return
+    endif
+    let a:envelope["body"] = {}
+    let a:envelope["error"] = a:error
+    call ch_sendexpr(a:channel, a:envelope)
+endfun
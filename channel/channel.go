@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math"
 	"net"
 	"sync/atomic"
 	"time"
@@ -19,9 +20,37 @@ const (
 
 var ErrChanClosed = errors.New("channel closed")
 
+// ErrMailboxesExhausted is returned by a Send if every RPC mailbox
+// awaits a response and none is freed within MaxMailboxWait.
+var ErrMailboxesExhausted = errors.New("channel: all mailboxes await a response")
+
+// Debug enables logging of every heartbeat.
+var Debug = false
+
 const (
-	RPCBoxNumOffset uint32 = 4
-	CMDBoxNumOffset uint32 = 0
+	// DefaultMailboxCapacity is the default number of mailboxes of a Channel.
+	DefaultMailboxCapacity = 1024
+	// DefaultMaxMailboxCapacity is the default number of mailboxes a Channel
+	// grows to.
+	DefaultMaxMailboxCapacity = 16384
+	// MaxMailboxWait is the longest a Send waits for a free mailbox
+	// once the mailboxes reached their maximum.
+	MaxMailboxWait = 5 * time.Second
+	// DefaultPingInterval is the default time between heartbeats.
+	DefaultPingInterval = 1 * time.Second
+	// DefaultPingTimeout is the default time Vim has to answer a heartbeat.
+	DefaultPingTimeout = 50 * time.Millisecond
+)
+
+const (
+	RPCBoxNumOffset     uint32 = 28
+	SessionBoxNumOffset uint32 = 24
+	KeymapBoxNumOffset  uint32 = 20
+	CmplBoxNumOffset    uint32 = 16
+	PopupBoxNumOffset   uint32 = 12
+	EventBoxNumOffset   uint32 = 8
+	BufEvBoxNumOffset   uint32 = 4
+	CMDBoxNumOffset     uint32 = 0
 )
 
 // Channel represents a Vim channel in JSON mode.
@@ -29,9 +58,32 @@ const (
 // A Channel maintains a mailbox where incoming RPC messages
 // are placed, in the form of Envelope data structures.
 //
-// Each Delivery polls its assigned mailbox until an Envelope is present.
+// Each Delivery checks its assigned mailbox and sleeps until the
+// Channel signals the mailbox was filled.
+//
+// Mailboxes are allocated as the number of pending requests grows, up to
+// a maximum. Sends wait for a free mailbox past the maximum.
+//
+// Requests carry a correlation ID naming their mailbox, see Send.
+// Responses are only delivered to the request they answer, a response
+// arriving after its request was canceled is logged and dropped.
 //
 // Mailbox numbers 0-3 are reserved for broadcasting registered commands.
+// Mailbox numbers 4-7 are reserved for broadcasting buffer change events.
+// Mailbox numbers 8-11 are reserved for broadcasting autocommand events.
+// Mailbox numbers 12-15 are reserved for broadcasting popup callbacks.
+// Mailbox numbers 16-19 are reserved for broadcasting completion requests.
+// Mailbox numbers 20-23 are reserved for broadcasting mapping uses.
+// Mailbox numbers 24-27 are reserved for broadcasting session events.
+//
+// Broadcasts arriving while their mailbox is occupied are queued
+// in order, see queue.
+//
+// Envelopes from Vim are validated before delivery, invalid Envelopes
+// are quarantined and rejected, see Recv.
+//
+// Outbound Envelopes are queued on priority lanes and written by
+// a single goroutine, see Transmit.
 //
 // In the occurence of an underlying TCP error the Channel delivers
 // a sentinel Envelope indicating error.
@@ -41,10 +93,19 @@ type Channel struct {
 	// following pointers guaranteed non nil if constructor
 	// is used.
 	State *int32 // atomically updated
-	conn  *net.TCPConn
-	*json.Encoder
+	conn  net.Conn
+	// only used by Transmit.
+	enc *json.Encoder
 	*json.Decoder
-	mailbox []*unsafe.Pointer
+	// outbound writes, one per Priority.
+	lanes   []chan write
+	mailbox *mailboxes
+	// broadcasts awaiting their mailbox, one per broadcast mailbox.
+	queues []*queue
+	// closed when the channel closes.
+	done chan struct{}
+	// sequence of the last correlation ID, atomically updated.
+	seq *uint32
 }
 
 // Close should be called on TCP terminating errors.
@@ -52,37 +113,41 @@ type Channel struct {
 // RPC will be provided to all clients.
 func (c *Channel) Close() {
 	e := &Envelope{
-		Err: fmt.Errorf("channel closed during rpc"),
+		Err: &Error{Code: "UNAVAILABLE", Message: "channel closed during rpc"},
 	}
 	// one caller will win this race.
 	ok := atomic.CompareAndSwapInt32(c.State, 1, 0)
 	if ok {
 		c.conn.Close()
-		for i, p := range c.mailbox {
-			if (*Envelope)(*p) != nil && !(*Envelope)(*p).In {
-				atomic.SwapPointer(c.mailbox[i], unsafe.Pointer(e))
+		close(c.done)
+		for i := uint32(0); c.mailbox.box(i) != nil; i++ {
+			box := c.mailbox.box(i)
+			if out := (*Envelope)(atomic.LoadPointer(&box.env)); out != nil && !out.In {
+				atomic.SwapPointer(&box.env, unsafe.Pointer(e))
 			}
 		}
 	}
 }
 
 // Ping sends synethic rpcs as a
-// heartbeat with Vim.
+// heartbeat with Vim every interval.
 //
 // Ping will close the connetion and return
-// if an underlying tcp error is encountered.
+// if an underlying tcp error is encountered or
+// Vim does not respond within timeout.
 // In this case Ping unblocks.
 //
 // Ping will also unblock if the channel enters
 // a closed state or the provided ctx is canceled.
-func (c Channel) Ping(ctx context.Context) {
+func (c Channel) Ping(ctx context.Context, interval time.Duration, timeout time.Duration) {
 	e := &Envelope{
-		RPC: "Ping",
+		RPC:      "Ping",
+		Priority: PriorityHigh,
 	}
-	t := time.NewTicker(1 * time.Second)
+	t := time.NewTicker(interval)
 	defer t.Stop()
 	for {
-		if *c.State == Closed {
+		if atomic.LoadInt32(c.State) == Closed {
 			log.Printf("channel closed during ping")
 			return
 		}
@@ -91,8 +156,16 @@ func (c Channel) Ping(ctx context.Context) {
 			log.Printf("channel: ctx cancled, closing channel: %v", ctx.Err())
 			return
 		case <-t.C:
-			tctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
-			env, err := c.Send(tctx, e).Wait(tctx)
+			tctx, cancel := context.WithTimeout(ctx, timeout)
+			d := c.Send(tctx, e)
+			if d.Err == ErrMailboxesExhausted || d.Err == context.DeadlineExceeded {
+				// no mailbox was freed in time, Vim is busy
+				// answering requests, not gone.
+				log.Printf("channel: skipping ping: %v", d.Err)
+				cancel()
+				continue
+			}
+			env, err := d.Wait(tctx)
 			if err != nil {
 				log.Printf("channel: err while sending ping, closing channel: %v", err)
 				c.Close()
@@ -105,25 +178,38 @@ func (c Channel) Ping(ctx context.Context) {
 				cancel()
 				return
 			}
-			log.Printf("channel: received pong!")
+			if Debug {
+				log.Printf("channel: received pong!")
+			}
 			cancel()
 		}
 	}
 }
 
-func NewChannel(conn *net.TCPConn) Channel {
+// NewChannel creates a Channel over conn with capacity mailboxes,
+// growing up to max mailboxes.
+//
+// capacity must exceed RPCBoxNumOffset.
+func NewChannel(conn net.Conn, capacity int, max int) Channel {
 	open := int32(1)
+	r := &limitReader{r: conn, max: MaxEnvelopeSize}
+	r.dec = json.NewDecoder(r)
 	c := Channel{
-		Encoder: json.NewEncoder(conn),
-		Decoder: json.NewDecoder(conn),
+		enc:     json.NewEncoder(conn),
+		Decoder: r.dec,
 		conn:    conn,
-		mailbox: make([]*unsafe.Pointer, 1024),
+		lanes:   make([]chan write, numLanes),
+		mailbox: newMailboxes(capacity, max),
+		queues:  make([]*queue, RPCBoxNumOffset),
+		done:    make(chan struct{}),
+		seq:     new(uint32),
 		State:   &open,
 	}
-	// initialize unsafes
-	for i := 0; i < 1024; i++ {
-		p := unsafe.Pointer(nil)
-		c.mailbox[i] = &p
+	for i := range c.queues {
+		c.queues[i] = &queue{}
+	}
+	for i := range c.lanes {
+		c.lanes[i] = make(chan write, LaneLength)
 	}
 	return c
 }
@@ -135,44 +221,87 @@ func NewChannel(conn *net.TCPConn) Channel {
 //
 // The Envelope's In field MUST be false.
 //
+// Send queues the Envelope on the lane of its Priority, blocking
+// while the lane is full.
+//
+// Send claims a free mailbox for the response and sets the Envelope's ID
+// to a correlation ID unique among the channel's pending requests: a
+// 31 bit sequence number in the upper and the mailbox number in the lower
+// 32 bits, keeping the ID a positive Vim Number.
+// Send blocks while every mailbox is in use, ErrMailboxesExhausted is
+// returned if none is freed within MaxMailboxWait and the ctx's error
+// if the ctx is done first.
+//
 // Any error encountered on a Send is deferred
 // until the Wait() call on the provided Deliverer.
 func (c Channel) Send(ctx context.Context, e *Envelope) *Delivery {
-	if *c.State == Closed {
-		return &Delivery{Err: fmt.Errorf("channel closed")}
+	if atomic.LoadInt32(c.State) == Closed {
+		return &Delivery{Err: ErrChanClosed}
 	}
-	// try to compare and swap a mailbox number
-	// until success or ctx cancel
-	boxNum := RPCBoxNumOffset
-	for ; boxNum < uint32(len(c.mailbox)); boxNum++ {
-		if ctx.Err() != nil {
-			return &Delivery{
-				Err: ctx.Err(),
-			}
-		}
-		e.Mailbox = boxNum
-		if ok := atomic.CompareAndSwapPointer(c.mailbox[boxNum], nil, unsafe.Pointer(e)); ok {
-			break
+	seq := atomic.AddUint32(c.seq, 1) & math.MaxInt32
+	if seq == 0 {
+		// zero is reserved for broadcasts.
+		seq = atomic.AddUint32(c.seq, 1) & math.MaxInt32
+	}
+	if ctx.Err() != nil {
+		return &Delivery{
+			Err: ctx.Err(),
 		}
 	}
+	boxNum, err := c.mailbox.claim(ctx, e, func(n uint32) uint64 {
+		return uint64(seq)<<32 | uint64(n)
+	})
+	if err != nil {
+		return &Delivery{Err: err}
+	}
 
 	vim, err := e.ToVim()
 	if err != nil {
+		c.mailbox.release(boxNum, unsafe.Pointer(e))
 		return &Delivery{
 			Err: fmt.Errorf("failed to encode to vim type: %v", err),
 		}
 	}
 
-	err = c.Encode(vim)
+	err = c.enqueue(ctx, e.Priority, write{vim: vim})
 	if err != nil {
-		log.Printf("channel: error sending, closing channel: %v", err)
-		c.Close()
+		c.mailbox.release(boxNum, unsafe.Pointer(e))
 		return &Delivery{Err: err}
 	}
 
 	return &Delivery{
 		Channel: c,
 		BoxNum:  boxNum,
+		ID:      e.ID,
+	}
+}
+
+// Reply answers an Envelope Vim sent with ch_evalexpr().
+//
+// The reply carries the request number of the Envelope it answers
+// and does not occupy a mailbox, as Vim does not respond to it.
+//
+// Reply blocks until the reply is written.
+func (c Channel) Reply(e *Envelope) error {
+	if atomic.LoadInt32(c.State) == Closed {
+		return ErrChanClosed
+	}
+
+	vim, err := e.ToVim()
+	if err != nil {
+		return fmt.Errorf("failed to encode to vim type: %v", err)
+	}
+
+	w := write{vim: vim, done: make(chan error, 1)}
+	err = c.enqueue(context.Background(), e.Priority, w)
+	if err != nil {
+		return err
+	}
+	select {
+	case err = <-w.done:
+		return err
+	case <-c.done:
+		return ErrChanClosed
 	}
 }
 
@@ -182,9 +311,16 @@ func (c Channel) Send(ctx context.Context, e *Envelope) *Delivery {
 //
 // When a json message is received the payload
 // will be placed in the channel's mailbox.
+//
+// A message failing validation, see decode, or answering a request
+// under the wrong rpc is quarantined: it is not delivered and Vim is
+// sent a RejectedRPC. A message exceeding MaxEnvelopeSize or which is
+// not JSON closes the channel: the stream of messages has no framing
+// besides JSON itself, so the start of the next message can not be
+// found past it.
 func (c Channel) Recv(ctx context.Context) {
 	for {
-		if *c.State == Closed {
+		if atomic.LoadInt32(c.State) == Closed {
 			log.Printf("channel: channel closed during recv")
 			return
 		}
@@ -192,27 +328,57 @@ func (c Channel) Recv(ctx context.Context) {
 			log.Printf("channel rcv ctx canceled: %v", ctx.Err())
 			return
 		}
-		vw, e := VimWrap{}, &Envelope{}
-		err := c.Decode(&vw)
+		var raw json.RawMessage
+		err := c.Decode(&raw)
 		if err != nil {
 			log.Printf("channel: error receiving, closing channel: %v", err)
 			c.Close()
 			break
 		}
-		if err = e.FromVim(vw); err != nil {
-			log.Printf("channel: could not create Envelope from VimWrap: %v", err)
+		e, err := c.decode(raw)
+		if err != nil {
+			c.quarantine(e, err)
+			continue
+		}
+		box := c.mailbox.box(e.Mailbox)
+		if e.Mailbox < RPCBoxNumOffset {
+			c.queues[e.Mailbox].push(&box.env, e)
+		} else if !c.respond(box, e) {
+			log.Printf("channel: dropping late %v response %v, its request is gone", e.RPC, e.ID)
+			continue
 		}
-		e.In = true
-		atomic.SwapPointer(c.mailbox[int(e.Mailbox)], unsafe.Pointer(e))
+		c.notify(box)
+	}
+}
+
+// notify wakes the Delivery waiting on a mailbox.
+//
+// A pending signal is not repeated, the Delivery checks
+// its mailbox once woken.
+func (c Channel) notify(box *mailbox) {
+	select {
+	case box.filled <- struct{}{}:
+	default:
 	}
 }
 
+// Stats returns the occupancy of the Channel's mailboxes and the
+// number of quarantined Envelopes.
+func (c Channel) Stats() Stats {
+	return c.mailbox.stats()
+}
+
+// Done returns a channel closed once the Channel closes.
+func (c Channel) Done() <-chan struct{} {
+	return c.done
+}
+
 // ChannelOpen reports whether the channel is opened or not.
 func (c Channel) ChannelOpen() bool {
 	switch {
-	case *(c.State) == Open:
+	case atomic.LoadInt32(c.State) == Open:
 		return true
-	case *(c.State) == Closed:
+	case atomic.LoadInt32(c.State) == Closed:
 		return false
 	}
 	panic("unreachable")
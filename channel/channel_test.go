@@ -0,0 +1,137 @@
+package channel
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net"
+	"sync/atomic"
+	"testing"
+)
+
+// fakeVim runs a Channel over a pipe to a fake Vim, which answers every
+// request with its own Envelope and sends the Envelopes of broadcasts
+// to the Channel.
+func fakeVim(tb testing.TB, broadcasts <-chan *Envelope) Channel {
+	tb.Helper()
+	conn, vim := net.Pipe()
+	ctx, cancel := context.WithCancel(context.Background())
+	c := NewChannel(conn, DefaultMailboxCapacity, DefaultMaxMailboxCapacity)
+	go c.Recv(ctx)
+	go c.Transmit(ctx)
+	tb.Cleanup(func() {
+		cancel()
+		c.Close()
+		vim.Close()
+	})
+
+	out := make(chan VimWrap, LaneLength)
+	go func() {
+		enc := json.NewEncoder(vim)
+		for {
+			var vw VimWrap
+			select {
+			case vw = <-out:
+			case e := <-broadcasts:
+				var err error
+				if vw, err = e.ToVim(); err != nil {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+			if enc.Encode(vw) != nil {
+				return
+			}
+		}
+	}()
+	go func() {
+		dec := json.NewDecoder(vim)
+		for {
+			var vw VimWrap
+			if dec.Decode(&vw) != nil {
+				return
+			}
+			select {
+			case out <- vw:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return c
+}
+
+// TestSendSequenceWraps sends requests across the wrap of the sequence,
+// their correlation IDs must be positive Vim Numbers with a sequence.
+func TestSendSequenceWraps(t *testing.T) {
+	c := fakeVim(t, nil)
+	ctx := context.Background()
+	for _, start := range []uint32{math.MaxInt32 - 1, math.MaxUint32 - 1} {
+		atomic.StoreUint32(c.seq, start)
+		for i := 0; i < 3; i++ {
+			d := c.Send(ctx, &Envelope{RPC: "Eval", Body: json.RawMessage(`{}`)})
+			if d.ID > math.MaxInt64 || d.ID>>32 == 0 {
+				t.Fatalf("sequence %v: invalid correlation ID %x", start+uint32(i)+1, d.ID)
+			}
+			if _, err := d.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkRoundTrip(b *testing.B) {
+	c := fakeVim(b, nil)
+	ctx := context.Background()
+	body := json.RawMessage(`{"expr":"1"}`)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d := c.Send(ctx, &Envelope{RPC: "Eval", Body: body})
+		if _, err := d.Wait(ctx); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkRoundTripParallel(b *testing.B) {
+	c := fakeVim(b, nil)
+	ctx := context.Background()
+	body := json.RawMessage(`{"expr":"1"}`)
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			d := c.Send(ctx, &Envelope{RPC: "Eval", Body: body})
+			if _, err := d.Wait(ctx); err != nil {
+				b.Error(err)
+				return
+			}
+		}
+	})
+}
+
+func BenchmarkBroadcast(b *testing.B) {
+	broadcasts := make(chan *Envelope)
+	c := fakeVim(b, broadcasts)
+	ctx := context.Background()
+	e := &Envelope{ID: 0, RPC: broadcastRPCs[0], Body: json.RawMessage(`{"command":"demo.hello"}`)}
+	go func(n int) {
+		for i := 0; i < n; i++ {
+			select {
+			case broadcasts <- e:
+			case <-c.Done():
+				return
+			}
+		}
+	}(b.N)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		d := Delivery{Channel: c, BoxNum: 0}
+		if _, err := d.Wait(ctx); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
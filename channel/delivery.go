@@ -3,41 +3,88 @@ package channel
 import (
 	"context"
 	"fmt"
-	"runtime"
 	"sync/atomic"
 )
 
 // Delivery provides a lock-free wait mechanism
 // for clients issueing a channel.Send
 //
-// Delivery will check its Channel's mailbox periodically
-// until an envelope is available.
+// Delivery checks its Channel's mailbox and sleeps
+// until the Channel signals the mailbox was filled.
 type Delivery struct {
 	Channel Channel
 	BoxNum  uint32
-	Err     error
+	// correlation ID of the request, zero when
+	// waiting on a broadcast mailbox.
+	ID  uint64
+	Err error
 }
 
 // Wait will block until a Vim response is delivered or the ctx is canceled.
 // Any errors from the call to Channel.Send() will be returned by Wait's
 // err value.
+//
+// The mailbox of a request is freed when Wait returns, a response
+// arriving afterwards is dropped. The Err of the response, the error
+// Vim answered with, is returned as Wait's err.
 func (d *Delivery) Wait(ctx context.Context) (env Envelope, err error) {
 	if d.Err != nil {
 		return Envelope{}, d.Err
 	}
+	box := d.Channel.mailbox.box(d.BoxNum)
+	if box == nil {
+		return Envelope{}, fmt.Errorf("mailbox %v does not exist", d.BoxNum)
+	}
 	for {
-		if *d.Channel.State == Closed {
-			return Envelope{}, fmt.Errorf("channel closed")
+		if atomic.LoadInt32(d.Channel.State) == Closed {
+			d.free()
+			return Envelope{}, ErrChanClosed
 		}
 		if ctx.Err() != nil {
+			d.free()
 			return Envelope{}, ctx.Err()
 		}
-		e := (*Envelope)(*d.Channel.mailbox[int(d.BoxNum)])
+		// fast path, the envelope arrived before
+		// or since the last signal.
+		p := atomic.LoadPointer(&box.env)
+		e := (*Envelope)(p)
 		if e != nil && e.In {
-			env = *e
-			atomic.SwapPointer(d.Channel.mailbox[int(env.Mailbox)], nil)
+			if d.BoxNum < RPCBoxNumOffset {
+				env = *e
+				d.Channel.queues[d.BoxNum].pop(&box.env)
+				return
+			}
+			if e.ID == d.ID && d.Channel.mailbox.release(d.BoxNum, p) {
+				if e.Err != nil {
+					return Envelope{}, e.Err
+				}
+				env = *e
+				return
+			}
+		}
+		select {
+		case <-box.filled:
+		case <-d.Channel.done:
+		case <-ctx.Done():
+		}
+	}
+}
+
+// free empties the mailbox of an abandoned request, whether it holds the
+// request or its response.
+func (d *Delivery) free() {
+	if d.BoxNum < RPCBoxNumOffset {
+		return
+	}
+	box := d.Channel.mailbox.box(d.BoxNum)
+	for {
+		p := atomic.LoadPointer(&box.env)
+		e := (*Envelope)(p)
+		if e == nil || e.ID != d.ID {
+			return
+		}
+		if d.Channel.mailbox.release(d.BoxNum, p) {
 			return
 		}
-		runtime.Gosched()
 	}
 }
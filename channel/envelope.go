@@ -19,21 +19,59 @@ type VimWrap [2]json.RawMessage
 // will be delivered to the correct mailbox.
 //
 // The Envelope's Err field must be checked for
-// any underlying tcp errors before assuming
-// the Body is a valid json response.
+// any underlying tcp errors or an error Vim answered
+// with before assuming the Body is a valid json response.
 type Envelope struct {
-	Mailbox uint32          `json:"mailbox"`
+	// ID correlates a response with the request it answers, see
+	// Channel.Send. Broadcasts carry their mailbox number.
+	ID      uint64          `json:"mailbox"`
+	Mailbox uint32          `json:"-"`
 	RPC     string          `json:"rpc"`
 	Body    json.RawMessage `json:"body"`
 	ReqNum  int             `jso:"request_number"`
-	Err     error           `json:"error"`
+	Err     *Error          `json:"error,omitempty"`
 	In      bool            `json:"-"`
+	// lane the Envelope is sent on, see Priority.
+	Priority Priority `json:"-"`
 }
 
+// Error is the error a Vim handler answers a request with, see
+// rpc#error#Reply.
+type Error struct {
+	// name of a gRPC status code such as "NOT_FOUND".
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// the exception the handler raised, nil if the handler
+	// reported the error itself.
+	Exception *Exception `json:"exception,omitempty"`
+}
+
+// Exception describes an exception raised by Vim, see
+// rpc#error#FromException.
+type Exception struct {
+	// Vim error number such as "E121", empty if the exception
+	// was not a Vim error.
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// where the exception was raised, v:throwpoint.
+	Throwpoint string `json:"throwpoint"`
+}
+
+func (e *Error) Error() string {
+	if e.Exception != nil && e.Exception.Throwpoint != "" {
+		return fmt.Sprintf("vim: %v: %v (%v)", e.Code, e.Message, e.Exception.Throwpoint)
+	}
+	return fmt.Sprintf("vim: %v: %v", e.Code, e.Message)
+}
+
+// ToVim wraps the Envelope in Vim's message syntax.
+//
+// The Envelope's ReqNum is zero unless the Envelope replies to a
+// message Vim sent with ch_evalexpr().
 func (e Envelope) ToVim() (VimWrap, error) {
 	vw := VimWrap{}
 	var err error
-	vw[0], err = json.Marshal(0)
+	vw[0], err = json.Marshal(e.ReqNum)
 	if err != nil {
 		return vw, err
 	}
@@ -0,0 +1,228 @@
+package channel
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+)
+
+const (
+	// segmentSize is the number of mailboxes allocated at once
+	// when the mailboxes grow.
+	segmentSize = 256
+)
+
+// mailbox holds a request awaiting its response, the response,
+// or a broadcast.
+type mailbox struct {
+	// *Envelope, atomically updated.
+	env unsafe.Pointer
+	// signals the mailbox was filled.
+	filled chan struct{}
+}
+
+type segment [segmentSize]mailbox
+
+// Stats describes the occupancy of a Channel's mailboxes.
+type Stats struct {
+	// allocated mailboxes, including the broadcast mailboxes.
+	Capacity int
+	// mailboxes the Channel may grow to.
+	MaxCapacity int
+	// requests awaiting a response.
+	InUse int
+	// most requests awaiting a response at once.
+	PeakInUse int
+	// Sends which found every mailbox in use and waited for one.
+	Waited uint64
+	// Sends which failed with ErrMailboxesExhausted.
+	Rejected uint64
+	// Envelopes from Vim set aside as invalid, see Recv.
+	Quarantined uint64
+}
+
+// mailboxes is the growable set of mailboxes of a Channel.
+//
+// Mailboxes are allocated in segments as the number of pending requests
+// grows, up to a maximum. Segments are never moved or freed, so finding
+// and filling a mailbox does not lock.
+type mailboxes struct {
+	// stats, atomically updated. first for
+	// 64-bit alignment on 32-bit platforms.
+	waited      uint64
+	rejected    uint64
+	quarantined uint64
+	inUse       int32
+	peak        int32
+
+	// *segment, nil until allocated.
+	segments []unsafe.Pointer
+	max      int32
+	// usable mailboxes, atomically updated.
+	capacity int32
+	// guards growing and replacing freed.
+	mu sync.Mutex
+	// closed and replaced when a mailbox is freed while Sends wait.
+	freed   chan struct{}
+	waiters int32
+}
+
+func newMailboxes(capacity int, max int) *mailboxes {
+	if max < capacity {
+		max = capacity
+	}
+	m := &mailboxes{
+		segments: make([]unsafe.Pointer, (max+segmentSize-1)/segmentSize),
+		max:      int32(max),
+		freed:    make(chan struct{}),
+	}
+	m.mu.Lock()
+	for int(m.capacity) < capacity {
+		m.grow()
+	}
+	m.capacity = int32(capacity)
+	m.mu.Unlock()
+	return m
+}
+
+// box returns mailbox n, nil if n is not allocated.
+func (m *mailboxes) box(n uint32) *mailbox {
+	if n >= uint32(atomic.LoadInt32(&m.capacity)) {
+		return nil
+	}
+	s := (*segment)(atomic.LoadPointer(&m.segments[n/segmentSize]))
+	return &s[n%segmentSize]
+}
+
+// grow allocates another segment of mailboxes, false is returned
+// if the maximum is reached.
+//
+// must be called with mu held.
+func (m *mailboxes) grow() bool {
+	n := atomic.LoadInt32(&m.capacity)
+	if n >= m.max {
+		return false
+	}
+	i := n / segmentSize
+	if atomic.LoadPointer(&m.segments[i]) == nil {
+		s := &segment{}
+		for j := range s {
+			s[j].filled = make(chan struct{}, 1)
+		}
+		atomic.StorePointer(&m.segments[i], unsafe.Pointer(s))
+	}
+	n = (i + 1) * segmentSize
+	if n > m.max {
+		n = m.max
+	}
+	// publish the segment before the mailboxes in it.
+	atomic.StoreInt32(&m.capacity, n)
+	return true
+}
+
+// claim places e in a free RPC mailbox and returns the mailbox number.
+//
+// If every mailbox is in use claim grows the mailboxes, or waits for
+// a mailbox to be freed once the maximum is reached. ErrMailboxesExhausted
+// is returned if none is freed within MaxMailboxWait, the ctx's error
+// if the ctx is done first.
+//
+// id computes the Envelope's ID from the mailbox number.
+func (m *mailboxes) claim(ctx context.Context, e *Envelope, id func(uint32) uint64) (uint32, error) {
+	waiting := false
+	var timeout <-chan time.Time
+	defer func() {
+		if waiting {
+			atomic.AddInt32(&m.waiters, -1)
+		}
+	}()
+	for {
+		if n, ok := m.scan(e, id); ok {
+			return n, nil
+		}
+
+		m.mu.Lock()
+		grown := m.grow()
+		freed := m.freed
+		if !grown && !waiting {
+			// from now on every free closes freed.
+			waiting = true
+			atomic.AddInt32(&m.waiters, 1)
+			atomic.AddUint64(&m.waited, 1)
+			t := time.NewTimer(MaxMailboxWait)
+			defer t.Stop()
+			timeout = t.C
+		}
+		m.mu.Unlock()
+		if grown {
+			continue
+		}
+
+		// a mailbox may have been freed before this Send waited.
+		if n, ok := m.scan(e, id); ok {
+			return n, nil
+		}
+		select {
+		case <-freed:
+		case <-timeout:
+			atomic.AddUint64(&m.rejected, 1)
+			return 0, ErrMailboxesExhausted
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+}
+
+// scan places e in the first free RPC mailbox.
+func (m *mailboxes) scan(e *Envelope, id func(uint32) uint64) (uint32, bool) {
+	n := uint32(atomic.LoadInt32(&m.capacity))
+	for i := RPCBoxNumOffset; i < n; i++ {
+		b := m.box(i)
+		if atomic.LoadPointer(&b.env) != nil {
+			continue
+		}
+		e.Mailbox = i
+		e.ID = id(i)
+		if atomic.CompareAndSwapPointer(&b.env, nil, unsafe.Pointer(e)) {
+			inUse := atomic.AddInt32(&m.inUse, 1)
+			for {
+				peak := atomic.LoadInt32(&m.peak)
+				if inUse <= peak || atomic.CompareAndSwapInt32(&m.peak, peak, inUse) {
+					break
+				}
+			}
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// release empties RPC mailbox n if it still holds p, returning whether
+// it did.
+func (m *mailboxes) release(n uint32, p unsafe.Pointer) bool {
+	if !atomic.CompareAndSwapPointer(&m.box(n).env, p, nil) {
+		return false
+	}
+	atomic.AddInt32(&m.inUse, -1)
+	if atomic.LoadInt32(&m.waiters) > 0 {
+		m.mu.Lock()
+		close(m.freed)
+		m.freed = make(chan struct{})
+		m.mu.Unlock()
+	}
+	return true
+}
+
+func (m *mailboxes) stats() Stats {
+	return Stats{
+		Capacity:    int(atomic.LoadInt32(&m.capacity)),
+		MaxCapacity: int(m.max),
+		InUse:       int(atomic.LoadInt32(&m.inUse)),
+		PeakInUse:   int(atomic.LoadInt32(&m.peak)),
+		Waited:      atomic.LoadUint64(&m.waited),
+		Rejected:    atomic.LoadUint64(&m.rejected),
+		Quarantined: atomic.LoadUint64(&m.quarantined),
+	}
+}
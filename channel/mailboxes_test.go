@@ -0,0 +1,31 @@
+package channel
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestClaimCanceled waits on full mailboxes until the ctx is done, the
+// ctx's error is returned and the Send is not counted as rejected.
+func TestClaimCanceled(t *testing.T) {
+	capacity := int(RPCBoxNumOffset) + 2
+	m := newMailboxes(capacity, capacity)
+	id := func(n uint32) uint64 { return 1<<32 | uint64(n) }
+	for i := int(RPCBoxNumOffset); i < capacity; i++ {
+		if _, err := m.claim(context.Background(), &Envelope{RPC: "Eval"}, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := m.claim(ctx, &Envelope{RPC: "Eval"}, id)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+	stats := m.stats()
+	if stats.Waited != 1 || stats.Rejected != 0 {
+		t.Fatalf("got %v waited and %v rejected, want 1 and 0", stats.Waited, stats.Rejected)
+	}
+}
@@ -0,0 +1,54 @@
+package channel
+
+import (
+	"log"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+const (
+	// maxQueued is the number of broadcasts a mailbox holds
+	// while its monitor is busy.
+	maxQueued = 1024
+)
+
+// queue orders the broadcasts of a mailbox.
+//
+// Vim may broadcast faster than a mailbox is emptied, for instance two
+// commands issued by the same mapping. Broadcasts finding the mailbox
+// occupied are queued and moved into it as it empties, instead of
+// overwriting the unread Envelope.
+type queue struct {
+	sync.Mutex
+	envs []*Envelope
+}
+
+// push places e in the mailbox pointed to by box, or queues it
+// behind the broadcasts already waiting.
+func (q *queue) push(box *unsafe.Pointer, e *Envelope) {
+	q.Lock()
+	defer q.Unlock()
+	if len(q.envs) == 0 && atomic.CompareAndSwapPointer(box, nil, unsafe.Pointer(e)) {
+		return
+	}
+	if len(q.envs) >= maxQueued {
+		log.Printf("channel: dropping %v broadcast, mailbox %v is full", e.RPC, e.Mailbox)
+		return
+	}
+	q.envs = append(q.envs, e)
+}
+
+// pop empties the mailbox pointed to by box, moving the next
+// queued broadcast into it.
+func (q *queue) pop(box *unsafe.Pointer) {
+	q.Lock()
+	defer q.Unlock()
+	if len(q.envs) == 0 {
+		atomic.StorePointer(box, nil)
+		return
+	}
+	atomic.StorePointer(box, unsafe.Pointer(q.envs[0]))
+	q.envs[0] = nil
+	q.envs = q.envs[1:]
+}
@@ -0,0 +1,192 @@
+package channel
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"sync/atomic"
+	"unsafe"
+)
+
+const (
+	// MaxEnvelopeSize is the largest message accepted from Vim, in bytes.
+	//
+	// A larger message closes the channel, the stream of messages can
+	// not be resynchronized past it.
+	MaxEnvelopeSize = 64 << 20
+	// RejectedRPC is the rpc of the error reply sent to Vim for each
+	// quarantined Envelope, see Channel.quarantine.
+	RejectedRPC = "EnvelopeRejected"
+)
+
+// ErrEnvelopeTooLarge is returned by Recv's reader once the message
+// being decoded exceeds MaxEnvelopeSize.
+var ErrEnvelopeTooLarge = fmt.Errorf("channel: message exceeds %v bytes", MaxEnvelopeSize)
+
+// broadcastRPCs is the rpc Vim broadcasts on each group of four
+// broadcast mailboxes, indexed by mailbox number / 4.
+var broadcastRPCs = [...]string{
+	"CommandIssued",
+	"BufferChanged",
+	"AutocmdFired",
+	"PopupClosed",
+	"CompletionRequested",
+	"KeymapTriggered",
+	"SessionFocused",
+}
+
+// responseRPCs maps the rpcs Vim answers under another name to the
+// name of their response, other rpcs are answered under their own name.
+var responseRPCs = map[string]string{
+	"Ping": "Pong",
+}
+
+// responseRPC returns the rpc of Vim's response to a request.
+func responseRPC(rpc string) string {
+	if r, ok := responseRPCs[rpc]; ok {
+		return r
+	}
+	return rpc
+}
+
+// limitReader fails reads once the message the Decoder is decoding
+// exceeds max bytes, MaxEnvelopeSize, bounding the memory a message
+// from Vim may claim.
+type limitReader struct {
+	r   io.Reader
+	dec *json.Decoder
+	max int64
+	// bytes read off r.
+	n int64
+}
+
+func (l *limitReader) Read(p []byte) (int, error) {
+	// read but not yet decoded, the partial message. The Decoder
+	// only reads while its buffer holds no complete message.
+	pending := l.n - l.dec.InputOffset()
+	if pending >= l.max {
+		return 0, ErrEnvelopeTooLarge
+	}
+	if max := l.max - pending; int64(len(p)) > max {
+		p = p[:max]
+	}
+	n, err := l.r.Read(p)
+	l.n += int64(n)
+	return n, err
+}
+
+// decode validates a message read off the conn and returns its Envelope.
+//
+// The Envelope must be a [number, envelope] pair naming an rpc and an
+// allocated mailbox. Broadcasts must carry the rpc of their mailbox and
+// no correlation ID. Whether a response answers its request is checked
+// by Recv.
+//
+// raw is a single JSON value, input which is not JSON closes the
+// channel before reaching decode, see Recv.
+//
+// On error the returned Envelope holds what could be decoded.
+func (c Channel) decode(raw json.RawMessage) (*Envelope, error) {
+	vw, e := VimWrap{}, &Envelope{}
+	if err := json.Unmarshal(raw, &vw); err != nil {
+		return e, fmt.Errorf("not a [number, envelope] message: %v", err)
+	}
+	if err := e.FromVim(vw); err != nil {
+		return e, err
+	}
+	e.In = true
+	e.Mailbox = uint32(e.ID)
+	if e.RPC == "" {
+		return e, errors.New("envelope names no rpc")
+	}
+	if c.mailbox.box(e.Mailbox) == nil || e.ID>>32 != 0 && e.Mailbox < RPCBoxNumOffset {
+		return e, fmt.Errorf("unknown mailbox %v", e.ID)
+	}
+	if e.Mailbox < RPCBoxNumOffset {
+		if want := broadcastRPCs[e.Mailbox/4]; e.RPC != want {
+			return e, fmt.Errorf("mailbox %v only takes %v broadcasts", e.Mailbox, want)
+		}
+	}
+	return e, nil
+}
+
+// rejection is the body of a RejectedRPC.
+type rejection struct {
+	RPC     string `json:"rpc"`
+	Mailbox uint64 `json:"mailbox"`
+	Error   string `json:"error"`
+}
+
+// quarantine sets aside an invalid Envelope from Vim instead of
+// delivering it, and replies with a RejectedRPC describing the error.
+//
+// The reply is dropped if the normal lane is full, quarantine never
+// blocks Recv.
+func (c Channel) quarantine(e *Envelope, err error) {
+	atomic.AddUint64(&c.mailbox.quarantined, 1)
+	log.Printf("channel: quarantined %q envelope for mailbox %v: %v", e.RPC, e.ID, err)
+
+	body, merr := json.Marshal(rejection{
+		RPC:     e.RPC,
+		Mailbox: e.ID,
+		Error:   err.Error(),
+	})
+	if merr != nil {
+		log.Printf("channel: failed to encode rejection: %v", merr)
+		return
+	}
+	// Vim routes a reply under the message's request number to a
+	// waiting ch_evalexpr(), and drops it for a ch_sendexpr(). The
+	// rejection is sent to the channel callback as well.
+	reqNums := []int{0}
+	if e.ReqNum != 0 {
+		reqNums = append(reqNums, e.ReqNum)
+	}
+	for _, n := range reqNums {
+		r := &Envelope{
+			RPC:    RejectedRPC,
+			Body:   body,
+			ReqNum: n,
+		}
+		vim, merr := r.ToVim()
+		if merr != nil {
+			log.Printf("channel: failed to encode rejection: %v", merr)
+			return
+		}
+		select {
+		case c.lanes[PriorityNormal] <- write{vim: vim}:
+		default:
+			log.Printf("channel: dropping rejection of %q envelope, lane full", e.RPC)
+		}
+	}
+}
+
+// respond places a response in the mailbox of the request it answers.
+//
+// False is returned if the mailbox no longer holds the request, its
+// Delivery was canceled. A response under the wrong rpc is quarantined
+// and fails the request instead.
+func (c Channel) respond(box *mailbox, e *Envelope) bool {
+	p := atomic.LoadPointer(&box.env)
+	req := (*Envelope)(p)
+	if req == nil || req.In || req.ID != e.ID {
+		return false
+	}
+	if want := responseRPC(req.RPC); e.RPC != want {
+		err := fmt.Errorf("%v response to %v request, want %v", e.RPC, req.RPC, want)
+		c.quarantine(e, err)
+		e = &Envelope{
+			ID:      e.ID,
+			Mailbox: e.Mailbox,
+			RPC:     e.RPC,
+			In:      true,
+			Err: &Error{
+				Code:    "INTERNAL",
+				Message: fmt.Sprintf("invalid envelope: %v", err),
+			},
+		}
+	}
+	return atomic.CompareAndSwapPointer(&box.env, p, unsafe.Pointer(e))
+}
@@ -0,0 +1,80 @@
+package channel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// FuzzDecode reads messages off the input as Recv does, with a smaller
+// envelope limit, and checks the Envelopes decode accepts.
+func FuzzDecode(f *testing.F) {
+	const max = 1 << 10
+	for _, seed := range []string{
+		`[0,{"mailbox":0,"rpc":"CommandIssued","body":{}}]`,
+		`[0,{"mailbox":4294967324,"rpc":"Eval","body":{}}] [0,{"mailbox":8,"rpc":"AutocmdFired"}]`,
+		// out of range mailboxes.
+		`[0,{"mailbox":1024,"rpc":"Eval","body":{}}]`,
+		`[0,{"mailbox":4294967296,"rpc":"CommandIssued","body":{}}]`,
+		`[0,{"mailbox":-1,"rpc":"Eval"}]`,
+		// rpc not matching the broadcast mailbox.
+		`[0,{"mailbox":4,"rpc":"CommandIssued","body":{}}]`,
+		`[0,{"mailbox":0,"rpc":""}]`,
+		// oversize.
+		`[0,{"mailbox":0,"rpc":"CommandIssued","body":"` + strings.Repeat("a", max) + `"}]`,
+		// not JSON, or not a [number, envelope] pair.
+		`[0,{"mailbox":0,`,
+		`vim`,
+		`{"mailbox":0}`,
+		`[0,1]`,
+	} {
+		f.Add([]byte(seed))
+	}
+	c := NewChannel(nil, DefaultMailboxCapacity, DefaultMailboxCapacity)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		r := &limitReader{r: bytes.NewReader(data), max: max}
+		r.dec = json.NewDecoder(r)
+		for {
+			var raw json.RawMessage
+			// the channel closes on a read error.
+			if err := r.dec.Decode(&raw); err != nil {
+				return
+			}
+			if len(raw) > max {
+				t.Fatalf("read a %v byte message, limit %v", len(raw), max)
+			}
+			e, err := c.decode(raw)
+			if err != nil {
+				continue
+			}
+			switch {
+			case e.RPC == "":
+				t.Fatalf("accepted %s naming no rpc", raw)
+			case c.mailbox.box(e.Mailbox) == nil || uint64(e.Mailbox) != e.ID&(1<<32-1):
+				t.Fatalf("accepted %s for unknown mailbox %v", raw, e.ID)
+			case e.Mailbox < RPCBoxNumOffset && (e.ID != uint64(e.Mailbox) || e.RPC != broadcastRPCs[e.Mailbox/4]):
+				t.Fatalf("accepted %s for broadcast mailbox %v", raw, e.Mailbox)
+			}
+		}
+	})
+}
+
+// TestRecvNotJSON sends a message which is not JSON, the channel must
+// close as the next message can not be found.
+func TestRecvNotJSON(t *testing.T) {
+	conn, vim := net.Pipe()
+	defer vim.Close()
+	c := NewChannel(conn, DefaultMailboxCapacity, DefaultMailboxCapacity)
+	go c.Recv(context.Background())
+	go vim.Write([]byte(`[0,{"mailbox":0,"rpc":"CommandIssued"}] vim [0,{"mailbox":0,"rpc":"CommandIssued"}]`))
+
+	select {
+	case <-c.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("channel not closed")
+	}
+}
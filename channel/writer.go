@@ -0,0 +1,87 @@
+package channel
+
+import (
+	"context"
+	"log"
+)
+
+// Priority selects the outbound lane of an Envelope.
+//
+// The writer drains higher priority lanes first, letting heartbeats
+// overtake queued bulk traffic.
+type Priority int
+
+const (
+	// PriorityNormal is the lane of rpcs and replies.
+	PriorityNormal Priority = iota
+	// PriorityHigh is the lane of heartbeats.
+	PriorityHigh
+	numLanes
+)
+
+const (
+	// LaneLength is the number of writes each lane holds
+	// before Send callers block.
+	LaneLength = 256
+)
+
+// write is an encoded Envelope awaiting the writer.
+type write struct {
+	vim VimWrap
+	// receives the outcome of the write if non nil.
+	done chan error
+}
+
+// enqueue hands a write to the writer, blocking while its lane is full.
+//
+// An error is returned if the ctx is canceled or the channel closes
+// before the lane has room.
+func (c Channel) enqueue(ctx context.Context, p Priority, w write) error {
+	if p < PriorityNormal || p >= numLanes {
+		p = PriorityNormal
+	}
+	select {
+	case c.lanes[p] <- w:
+		return nil
+	case <-c.done:
+		return ErrChanClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Transmit writes the queued Envelopes to Vim until the ctx is
+// canceled or the underlying conn fails.
+//
+// Transmit is the only writer of the conn, it must be running for
+// Send and Reply to make progress. The channel is closed when
+// Transmit returns.
+func (c Channel) Transmit(ctx context.Context) {
+	defer c.Close()
+	high, normal := c.lanes[PriorityHigh], c.lanes[PriorityNormal]
+	for {
+		var w write
+		select {
+		case w = <-high:
+		default:
+			select {
+			case w = <-high:
+			case w = <-normal:
+			case <-c.done:
+				return
+			case <-ctx.Done():
+				log.Printf("channel: transmit ctx canceled: %v", ctx.Err())
+				return
+			}
+		}
+
+		err := c.enc.Encode(w.vim)
+		if w.done != nil {
+			w.done <- err
+		}
+		if err != nil {
+			log.Printf("channel: error sending, closing channel: %v", err)
+			return
+		}
+	}
+}
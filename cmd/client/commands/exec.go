@@ -0,0 +1,57 @@
+package commands
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	pb "github.com/ldelossa/vim-grpc.vim/proto/commands"
+)
+
+var execFS = flag.NewFlagSet("commands exec", flag.ExitOnError)
+
+var execFlags = struct {
+	command *string
+	rng     *string
+	bang    *bool
+	args    *string
+}{
+	command: execFS.String("cmd", "", "id of the registered command to execute, such as ext.cmd (required)"),
+	rng:     execFS.String("range", "", "range or count to execute the command with, such as % or 2,5"),
+	bang:    execFS.Bool("bang", false, "execute the command with a !"),
+	args:    execFS.String("args", "", "arguments to execute the command with"),
+}
+
+func exec(ctx context.Context, client pb.CommandsClient) error {
+	execFS.Usage = func() {
+		fmt.Print(`Usage of commands exec:
+  -args string
+        arguments to execute the command with
+  -bang
+        execute the command with a !
+  -cmd string
+        id of the registered command to execute, such as ext.cmd (required)
+  -range string
+        range or count to execute the command with, such as % or 2,5
+
+The command is executed in Vim as if the user typed it.
+`)
+	}
+	execFS.Parse(os.Args[3:])
+
+	if *execFlags.command == "" {
+		return fmt.Errorf("'cmd' argument required")
+	}
+
+	_, err := client.ExecCommand(ctx, &pb.ExecCommandRequest{
+		Command: *execFlags.command,
+		Range:   *execFlags.rng,
+		Bang:    *execFlags.bang,
+		Args:    *execFlags.args,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to execute command: %v", err)
+	}
+	return nil
+}
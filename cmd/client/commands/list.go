@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	pb "github.com/ldelossa/vim-grpc.vim/proto/commands"
+)
+
+var listFS = flag.NewFlagSet("commands list", flag.ExitOnError)
+
+var listFlags = struct {
+	extension *string
+}{
+	extension: listFS.String("ext", "", "only list the commands of this extension"),
+}
+
+func list(ctx context.Context, client pb.CommandsClient) error {
+	listFS.Usage = func() {
+		fmt.Print(`Usage of commands list:
+  -ext string
+        only list the commands of this extension
+`)
+	}
+	listFS.Parse(os.Args[3:])
+
+	resp, err := client.ListCommands(ctx, &pb.ListCommandsRequest{
+		Extension: *listFlags.extension,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list commands: %v", err)
+	}
+
+	for _, cmd := range resp.Commands {
+		status := "connected"
+		if !cmd.Connected {
+			status = "disconnected"
+		}
+		fmt.Printf("%s\t%s\t%s\n", cmd.Id, cmd.Title, status)
+	}
+	return nil
+}
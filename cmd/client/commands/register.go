@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	pb "github.com/ldelossa/vim-grpc.vim/proto/commands"
 )
@@ -16,19 +17,59 @@ var registerFlags = struct {
 	extension *string
 	command   *string
 	title     *string
+	nargs     *string
+	rng       *string
+	count     *string
+	bang      *bool
+	buffer    *bool
+	complete  *string
+	reply     *string
+	display   *string
+	timeout   *int64
+	conflict  *string
 }{
 	extension: registerFS.String("ext", "", "name of the extension registering this command (required)"),
 	command:   registerFS.String("cmd", "", "name of the command being registered (required)"),
 	title:     registerFS.String("title", "", "title of the command in Vim (required)"),
+	nargs:     registerFS.String("nargs", "", "number of arguments the command accepts: 0, 1, *, ? or +"),
+	rng:       registerFS.String("range", "", "range the command accepts: ., % or a default count"),
+	count:     registerFS.String("count", "", "default count of the command"),
+	bang:      registerFS.Bool("bang", false, "the command accepts a !"),
+	buffer:    registerFS.Bool("buffer", false, "the command is local to the current buffer"),
+	complete:  registerFS.String("complete", "", "argument completion of the command, such as file"),
+	reply:     registerFS.String("reply", "", "text to reply to each invocation with"),
+	display:   registerFS.String("display", "echo", "how Vim shows the reply: echo, popup or scratch"),
+	timeout:   registerFS.Int64("timeout", 0, "milliseconds Vim waits on a reply, zero for the default"),
+	conflict:  registerFS.String("conflict", "reject", "what to do if the command is taken: reject, replace or prefix"),
 }
 
 func register(ctx context.Context, client pb.CommandsClient) error {
 	registerFS.Usage = func() {
 		fmt.Print(`Usage of commands register:
+  -bang
+        the command accepts a !
+  -buffer
+        the command is local to the current buffer
   -cmd string
         name of the command being registered (required)
+  -complete string
+        argument completion of the command, such as file
+  -conflict string
+        what to do if the command is taken: reject, replace or prefix (default "reject")
+  -count string
+        default count of the command
+  -display string
+        how Vim shows the reply: echo, popup or scratch (default "echo")
   -ext string
         name of the extension registering this command (required)
+  -nargs string
+        number of arguments the command accepts: 0, 1, *, ? or +
+  -range string
+        range the command accepts: ., % or a default count
+  -reply string
+        text to reply to each invocation with
+  -timeout int
+        milliseconds Vim waits on a reply, zero for the default
   -title string
         title of the command in Vim (required)
 
@@ -46,11 +87,28 @@ On successful registration issuing the command at Vim will log a message here fo
 	if *registerFlags.title == "" {
 		return fmt.Errorf("'title' argument required")
 	}
+	display, ok := pb.Display_value[strings.ToUpper(*registerFlags.display)]
+	if !ok {
+		return fmt.Errorf("unknown display: %v", *registerFlags.display)
+	}
+	conflict, ok := pb.ConflictPolicy_value[strings.ToUpper(*registerFlags.conflict)]
+	if !ok {
+		return fmt.Errorf("unknown conflict policy: %v", *registerFlags.conflict)
+	}
 
 	req := &pb.RegisterCommandRequest{
 		Extension: *registerFlags.extension,
 		Command:   *registerFlags.command,
 		Title:     *registerFlags.title,
+		Nargs:     *registerFlags.nargs,
+		Range:     *registerFlags.rng,
+		Count:     *registerFlags.count,
+		Bang:      *registerFlags.bang,
+		Buffer:    *registerFlags.buffer,
+		Complete:  *registerFlags.complete,
+		Reply:     *registerFlags.reply != "",
+		TimeoutMs: *registerFlags.timeout,
+		Conflict:  pb.ConflictPolicy(conflict),
 	}
 	stream, err := client.RegisterCommand(ctx, req)
 	if err != nil {
@@ -71,6 +129,7 @@ On successful registration issuing the command at Vim will log a message here fo
 	if !reg.Registered {
 		return fmt.Errorf("registration failed: %v", reg.Reason)
 	}
+	log.Printf("registered command %v as :%v", reg.Id, reg.Title)
 
 	for {
 		event, err := stream.Recv()
@@ -82,5 +141,16 @@ On successful registration issuing the command at Vim will log a message here fo
 			return fmt.Errorf("received unhandled message, closing command channel.")
 		}
 		log.Printf("command triggered: %+v", issued)
+		if !req.Reply {
+			continue
+		}
+		_, err = client.CompleteCommand(ctx, &pb.CompleteCommandRequest{
+			Id:      issued.Id,
+			Text:    *registerFlags.reply,
+			Display: pb.Display(display),
+		})
+		if err != nil {
+			log.Printf("failed to complete command: %v", err)
+		}
 	}
 }
@@ -12,9 +12,10 @@ import (
 const (
 	help = `
 The 'commands' sub-command is used to register extension commands with Vim.
-register - registers a command with Vim
-list     - list all registered commands 
-exec     - execute a command
+register   - registers a command with Vim
+list       - list all registered commands
+exec       - execute a command
+unregister - remove a registered command from Vim
 
 `
 )
@@ -31,6 +32,12 @@ func Root(ctx context.Context, conn *grpc.ClientConn) error {
 	switch sub {
 	case "register":
 		return register(ctx, client)
+	case "list":
+		return list(ctx, client)
+	case "exec":
+		return exec(ctx, client)
+	case "unregister":
+		return unregister(ctx, client)
 	default:
 		return fmt.Errorf("error: unknown subcommand: %v", sub)
 	}
@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	pb "github.com/ldelossa/vim-grpc.vim/proto/commands"
+)
+
+var unregisterFS = flag.NewFlagSet("commands unregister", flag.ExitOnError)
+
+var unregisterFlags = struct {
+	command *string
+}{
+	command: unregisterFS.String("cmd", "", "id of the registered command to remove, such as ext.cmd (required)"),
+}
+
+func unregister(ctx context.Context, client pb.CommandsClient) error {
+	unregisterFS.Usage = func() {
+		fmt.Print(`Usage of commands unregister:
+  -cmd string
+        id of the registered command to remove, such as ext.cmd (required)
+`)
+	}
+	unregisterFS.Parse(os.Args[3:])
+
+	if *unregisterFlags.command == "" {
+		return fmt.Errorf("'cmd' argument required")
+	}
+
+	_, err := client.UnregisterCommand(ctx, &pb.UnregisterCommandRequest{
+		Command: *unregisterFlags.command,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to unregister command: %v", err)
+	}
+	return nil
+}
@@ -7,7 +7,10 @@ import (
 	"time"
 
 	"github.com/ldelossa/vim-grpc.vim/cmd/client/commands"
+	"github.com/ldelossa/vim-grpc.vim/cmd/client/sessions"
+	"github.com/ldelossa/vim-grpc.vim/proxy"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
@@ -17,11 +20,24 @@ const (
 The following subcommands are available:
 
 commands - this command is used to register extension commands with vim-grpc and logs a message when the command issued at Vim.
+sessions - this command lists the Vims connected to vim-grpc.
+
+Calls are routed to the Vim named by the VGRPC_SESSION environment variable, or to the most recently focused Vim if unset.
+
+The VGRPC_ADDR environment variable overrides the server address, "unix:<path>" connects to a Unix socket and "unix:" to the per-user default socket.
 `
 )
 
 func main() {
-	conn, err := grpc.Dial(DefaultGRPCServerAddr,
+	addr := DefaultGRPCServerAddr
+	if v := os.Getenv("VGRPC_ADDR"); v != "" {
+		addr = v
+	}
+	if path, ok := proxy.SocketPath(addr, proxy.GRPCSocket); ok {
+		addr = proxy.UnixScheme + path
+	}
+
+	conn, err := grpc.Dial(addr,
 		grpc.WithTimeout(5*time.Second),
 		grpc.WithBlock(),
 		grpc.WithInsecure())
@@ -36,9 +52,20 @@ func main() {
 		os.Exit(1)
 	}
 
+	ctx := context.TODO()
+	if id := os.Getenv("VGRPC_SESSION"); id != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, proxy.MetadataSession, id)
+	}
+
 	switch os.Args[1] {
 	case "commands":
-		err := commands.Root(context.TODO(), conn)
+		err := commands.Root(ctx, conn)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	case "sessions":
+		err := sessions.Root(ctx, conn)
 		if err != nil {
 			fmt.Println(err)
 			os.Exit(1)
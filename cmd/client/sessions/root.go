@@ -0,0 +1,34 @@
+package sessions
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/ldelossa/vim-grpc.vim/proto/sessions"
+	"google.golang.org/grpc"
+)
+
+// Root lists the connected Vims, the focused Vim is marked with a '*'.
+//
+// Each Vim is followed by the occupancy of its mailboxes.
+func Root(ctx context.Context, conn *grpc.ClientConn) error {
+	client := pb.NewSessionsClient(conn)
+
+	resp, err := client.ListSessions(ctx, &pb.ListSessionsRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to list sessions: %v", err)
+	}
+
+	for _, s := range resp.Sessions {
+		focused := " "
+		if s.Focused {
+			focused = "*"
+		}
+		fmt.Printf("%s %s\t%d\t%s\t%s\n", focused, s.Id, s.Pid, s.Cwd, s.Servername)
+		if m := s.Mailboxes; m != nil {
+			fmt.Printf("  mailboxes: %d/%d allocated, %d in use, peak %d, waited %d, rejected %d, quarantined %d\n",
+				m.Capacity, m.MaxCapacity, m.InUse, m.PeakInUse, m.Waited, m.Rejected, m.Quarantined)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,325 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ldelossa/vim-grpc.vim/channel"
+	"github.com/ldelossa/vim-grpc.vim/proxy"
+)
+
+// Duration is a time.Duration encoded as a string such as "1s" in
+// the config file.
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// Config is the configuration of the vgrpc daemon.
+//
+// Each setting is read from, in increasing precedence, its default,
+// the config file, its VGRPC_ environment variable and its flag.
+type Config struct {
+	// address Vim connects to, see proxy.NewListener.
+	VimAddr string `json:"vim_addr"`
+	// address gRPC clients connect to, see proxy.NewListener.
+	GRPCAddr string `json:"grpc_addr"`
+	// time between heartbeats sent to Vim.
+	PingInterval Duration `json:"ping_interval"`
+	// time Vim has to answer a heartbeat.
+	PingTimeout Duration `json:"ping_timeout"`
+	// one of "debug", "info" or "off".
+	LogLevel string `json:"log_level"`
+	// "stderr", "stdout" or the path of a file logs are appended to.
+	LogOutput string `json:"log_output"`
+	// mailboxes each Vim channel starts with.
+	MailboxCapacity int `json:"mailbox_capacity"`
+	// mailboxes each Vim channel may grow to.
+	MaxMailboxCapacity int `json:"max_mailbox_capacity"`
+	// names of the enabled gRPC services, see services.
+	Services []string `json:"services"`
+}
+
+// DefaultConfig returns the configuration of a daemon started
+// without a config file, environment or flags.
+func DefaultConfig() Config {
+	return Config{
+		VimAddr:            fmt.Sprintf("localhost:%v", proxy.DefaultPort),
+		GRPCAddr:           GRPCListenAddr,
+		PingInterval:       Duration(channel.DefaultPingInterval),
+		PingTimeout:        Duration(channel.DefaultPingTimeout),
+		LogLevel:           "info",
+		LogOutput:          "stderr",
+		MailboxCapacity:    channel.DefaultMailboxCapacity,
+		MaxMailboxCapacity: channel.DefaultMaxMailboxCapacity,
+		Services:           append([]string(nil), serviceNames...),
+	}
+}
+
+// DefaultConfigPath returns the config file read when -config is not set.
+func DefaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "vgrpc", "config.json")
+}
+
+// setting is a configurable field of Config.
+type setting struct {
+	// flag name, the environment variable is its upper cased
+	// VGRPC_ prefixed form.
+	name  string
+	usage string
+	set   func(c *Config, v string) error
+}
+
+var settings = []setting{
+	{
+		name:  "vim-addr",
+		usage: `address Vim connects to, "unix:<path>" for a Unix socket or "unix:" for the per-user default socket`,
+		set: func(c *Config, v string) error {
+			c.VimAddr = v
+			return nil
+		},
+	},
+	{
+		name:  "grpc-addr",
+		usage: `address gRPC clients connect to, "unix:<path>" for a Unix socket or "unix:" for the per-user default socket`,
+		set: func(c *Config, v string) error {
+			c.GRPCAddr = v
+			return nil
+		},
+	},
+	{
+		name:  "ping-interval",
+		usage: "time between heartbeats sent to Vim",
+		set: func(c *Config, v string) error {
+			d, err := time.ParseDuration(v)
+			c.PingInterval = Duration(d)
+			return err
+		},
+	},
+	{
+		name:  "ping-timeout",
+		usage: "time Vim has to answer a heartbeat before its channel is closed",
+		set: func(c *Config, v string) error {
+			d, err := time.ParseDuration(v)
+			c.PingTimeout = Duration(d)
+			return err
+		},
+	},
+	{
+		name:  "log-level",
+		usage: `one of "debug", "info" or "off"`,
+		set: func(c *Config, v string) error {
+			c.LogLevel = v
+			return nil
+		},
+	},
+	{
+		name:  "log-output",
+		usage: `"stderr", "stdout" or the path of a file logs are appended to`,
+		set: func(c *Config, v string) error {
+			c.LogOutput = v
+			return nil
+		},
+	},
+	{
+		name:  "mailbox-capacity",
+		usage: "mailboxes each Vim channel starts with",
+		set: func(c *Config, v string) error {
+			n, err := strconv.Atoi(v)
+			c.MailboxCapacity = n
+			return err
+		},
+	},
+	{
+		name:  "max-mailbox-capacity",
+		usage: "mailboxes each Vim channel may grow to, requests beyond wait for a free mailbox",
+		set: func(c *Config, v string) error {
+			n, err := strconv.Atoi(v)
+			c.MaxMailboxCapacity = n
+			return err
+		},
+	},
+	{
+		name:  "services",
+		usage: "comma separated gRPC services to enable, " + strings.Join(serviceNames, ","),
+		set: func(c *Config, v string) error {
+			c.Services = nil
+			for _, name := range strings.Split(v, ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					c.Services = append(c.Services, name)
+				}
+			}
+			return nil
+		},
+	},
+}
+
+// env returns the environment variable of a setting.
+func (s setting) env() string {
+	return "VGRPC_" + strings.ToUpper(strings.ReplaceAll(s.name, "-", "_"))
+}
+
+// LoadConfig parses the command line and returns the effective
+// configuration and whether it should be printed instead of serving.
+func LoadConfig(fs *flag.FlagSet, args []string) (Config, bool, error) {
+	path := fs.String("config", "", "path of the JSON config file, defaults to "+DefaultConfigPath())
+	printConfig := fs.Bool("print-config", false, "print the effective configuration and exit")
+	for _, s := range settings {
+		// the value is applied below, after the config file
+		// and environment.
+		fs.String(s.name, "", fmt.Sprintf("%v (env %v)", s.usage, s.env()))
+	}
+	if err := fs.Parse(args); err != nil {
+		return Config{}, false, err
+	}
+
+	c := DefaultConfig()
+	if *path != "" {
+		if err := c.readFile(*path); err != nil {
+			return Config{}, false, err
+		}
+	} else if p := DefaultConfigPath(); p != "" {
+		// the default config file is optional.
+		if err := c.readFile(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return Config{}, false, err
+		}
+	}
+
+	for _, s := range settings {
+		if v, ok := os.LookupEnv(s.env()); ok {
+			if err := s.set(&c, v); err != nil {
+				return Config{}, false, fmt.Errorf("invalid %v: %v", s.env(), err)
+			}
+		}
+	}
+	var err error
+	fs.Visit(func(f *flag.Flag) {
+		for _, s := range settings {
+			if s.name == f.Name && err == nil {
+				if serr := s.set(&c, f.Value.String()); serr != nil {
+					err = fmt.Errorf("invalid -%v: %v", s.name, serr)
+				}
+			}
+		}
+	})
+	if err != nil {
+		return Config{}, false, err
+	}
+	return c, *printConfig, c.validate()
+}
+
+// readFile overrides the configuration with the settings of a config file.
+func (c *Config) readFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(c); err != nil {
+		return fmt.Errorf("failed to read config file %v: %v", path, err)
+	}
+	return nil
+}
+
+func (c *Config) validate() error {
+	if c.VimAddr == "" || c.GRPCAddr == "" {
+		return fmt.Errorf("vim and grpc addresses are required")
+	}
+	if c.PingInterval <= 0 || c.PingTimeout <= 0 {
+		return fmt.Errorf("ping interval and timeout must be positive")
+	}
+	switch c.LogLevel {
+	case "debug", "info", "off":
+	default:
+		return fmt.Errorf("invalid log level: %q", c.LogLevel)
+	}
+	if c.LogOutput == "" {
+		return fmt.Errorf("log output is required")
+	}
+	if c.MailboxCapacity <= int(channel.RPCBoxNumOffset) {
+		return fmt.Errorf("mailbox capacity must exceed %v", channel.RPCBoxNumOffset)
+	}
+	if c.MaxMailboxCapacity < c.MailboxCapacity {
+		return fmt.Errorf("max mailbox capacity must be at least the mailbox capacity %v", c.MailboxCapacity)
+	}
+	// mailbox numbers are the low 32 bits of a correlation ID.
+	if c.MaxMailboxCapacity > math.MaxInt32 {
+		return fmt.Errorf("max mailbox capacity must not exceed %v", math.MaxInt32)
+	}
+	seen := map[string]bool{}
+	for _, name := range c.Services {
+		if _, ok := services[name]; !ok {
+			return fmt.Errorf("unknown service: %q", name)
+		}
+		if seen[name] {
+			return fmt.Errorf("service %q enabled twice", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// Logger configures the log package and returns the log file,
+// nil if logs are not written to a file.
+func (c *Config) Logger() (*os.File, error) {
+	channel.Debug = c.LogLevel == "debug"
+	if c.LogLevel == "off" {
+		log.SetOutput(ioutil.Discard)
+		return nil, nil
+	}
+	switch c.LogOutput {
+	case "stderr":
+		log.SetOutput(os.Stderr)
+	case "stdout":
+		log.SetOutput(os.Stdout)
+	default:
+		f, err := os.OpenFile(c.LogOutput, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			return nil, err
+		}
+		log.SetOutput(f)
+		return f, nil
+	}
+	return nil, nil
+}
+
+// Options returns the proxy.Options of the configuration.
+func (c *Config) Options() proxy.Options {
+	return proxy.Options{
+		PingInterval:       time.Duration(c.PingInterval),
+		PingTimeout:        time.Duration(c.PingTimeout),
+		MailboxCapacity:    c.MailboxCapacity,
+		MaxMailboxCapacity: c.MaxMailboxCapacity,
+	}
+}
@@ -3,13 +3,25 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
 	"log"
-	"net"
 	"os"
 	"os/signal"
 
+	buffers "github.com/ldelossa/vim-grpc.vim/proto"
 	cmds "github.com/ldelossa/vim-grpc.vim/proto/commands"
+	completion "github.com/ldelossa/vim-grpc.vim/proto/completion"
+	diagnostics "github.com/ldelossa/vim-grpc.vim/proto/diagnostics"
+	editor "github.com/ldelossa/vim-grpc.vim/proto/editor"
 	env "github.com/ldelossa/vim-grpc.vim/proto/env"
+	events "github.com/ldelossa/vim-grpc.vim/proto/events"
+	keymaps "github.com/ldelossa/vim-grpc.vim/proto/keymaps"
+	quickfix "github.com/ldelossa/vim-grpc.vim/proto/quickfix"
+	sessions "github.com/ldelossa/vim-grpc.vim/proto/sessions"
+	ui "github.com/ldelossa/vim-grpc.vim/proto/ui"
+	windows "github.com/ldelossa/vim-grpc.vim/proto/windows"
 	"github.com/ldelossa/vim-grpc.vim/proxy"
 	"google.golang.org/grpc"
 )
@@ -18,16 +30,71 @@ const (
 	GRPCListenAddr = "localhost:8080"
 )
 
+// serviceNames lists the gRPC services in registration order.
+var serviceNames = []string{
+	"env",
+	"commands",
+	"buffers",
+	"events",
+	"editor",
+	"windows",
+	"ui",
+	"quickfix",
+	"diagnostics",
+	"completion",
+	"keymaps",
+	"sessions",
+}
+
+// services registers each gRPC service by its name in the configuration.
+var services = map[string]func(*grpc.Server, *proxy.Proxy){
+	"env":         func(s *grpc.Server, p *proxy.Proxy) { env.RegisterEnvServer(s, p) },
+	"commands":    func(s *grpc.Server, p *proxy.Proxy) { cmds.RegisterCommandsServer(s, p) },
+	"buffers":     func(s *grpc.Server, p *proxy.Proxy) { buffers.RegisterProxyServer(s, p) },
+	"events":      func(s *grpc.Server, p *proxy.Proxy) { events.RegisterEventsServer(s, p) },
+	"editor":      func(s *grpc.Server, p *proxy.Proxy) { editor.RegisterEditorServer(s, p) },
+	"windows":     func(s *grpc.Server, p *proxy.Proxy) { windows.RegisterWindowsServer(s, p) },
+	"ui":          func(s *grpc.Server, p *proxy.Proxy) { ui.RegisterUIServer(s, p) },
+	"quickfix":    func(s *grpc.Server, p *proxy.Proxy) { quickfix.RegisterQuickfixServer(s, p) },
+	"diagnostics": func(s *grpc.Server, p *proxy.Proxy) { diagnostics.RegisterDiagnosticsServer(s, p) },
+	"completion":  func(s *grpc.Server, p *proxy.Proxy) { completion.RegisterCompletionServer(s, p) },
+	"keymaps":     func(s *grpc.Server, p *proxy.Proxy) { keymaps.RegisterKeymapsServer(s, p) },
+	"sessions":    func(s *grpc.Server, p *proxy.Proxy) { sessions.RegisterSessionsServer(s, p) },
+}
+
 func main() {
+	cfg, printConfig, err := LoadConfig(flag.CommandLine, os.Args[1:])
+	if err != nil {
+		log.Fatalf("invalid configuration: %v", err)
+	}
+	if printConfig {
+		b, err := json.MarshalIndent(cfg, "", "  ")
+		if err != nil {
+			log.Fatalf("failed to encode configuration: %v", err)
+		}
+		fmt.Println(string(b))
+		return
+	}
+	logFile, err := cfg.Logger()
+	if err != nil {
+		log.Fatalf("failed to open log output: %v", err)
+	}
+	if logFile != nil {
+		defer logFile.Close()
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 
 	// create and start the proxy.
-	// creates the tcp socket vim will
+	// creates the socket vim will
 	// connect to.
-	p := proxy.NewProxy(ctx)
-	log.Printf("starting proxy on localhost:%v", proxy.DefaultPort)
+	p := proxy.NewProxy(ctx, cfg.Options())
+	log.Printf("starting proxy on %v", cfg.VimAddr)
+	// closed once the proxy stopped listening.
+	proxyDone := make(chan struct{})
 	go func() {
-		err := p.Listen(ctx)
+		defer close(proxyDone)
+		err := p.Listen(ctx, cfg.VimAddr)
 		if err != nil {
 			log.Printf("failed to start proxy: %v", err)
 			cancel()
@@ -35,19 +102,24 @@ func main() {
 	}()
 
 	// create and start the gRPC server.
-	// registers the gRPC server and Proxy service
-	// gRPC clients will connect to.
-	lis, err := net.Listen("tcp", GRPCListenAddr)
+	// registers the gRPC server and the enabled
+	// Proxy services gRPC clients will connect to.
+	lis, err := proxy.NewListener(cfg.GRPCAddr, proxy.GRPCSocket)
 	if err != nil {
 		log.Fatalf("failed to create gRPC listener: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(proxy.UnaryInterceptor),
+		grpc.StreamInterceptor(proxy.StreamInterceptor),
+	)
 
-	env.RegisterEnvServer(grpcServer, p)
-	cmds.RegisterCommandsServer(grpcServer, p)
+	for _, name := range cfg.Services {
+		services[name](grpcServer, p)
+	}
+	log.Printf("enabled services: %v", cfg.Services)
 
-	log.Printf("starting grpc server on %v", GRPCListenAddr)
+	log.Printf("starting grpc server on %v", cfg.GRPCAddr)
 	go func() {
 		if err := grpcServer.Serve(lis); err != nil {
 			log.Printf("error starting grpc server: %v", err)
@@ -56,7 +128,7 @@ func main() {
 	}()
 
 	// block main thread on sigint or ctx cancelation.
-	sig := make(chan os.Signal)
+	sig := make(chan os.Signal, 1)
 	signal.Notify(sig, os.Interrupt)
 	select {
 	case <-sig:
@@ -67,4 +139,5 @@ func main() {
 	}
 	cancel()
 	grpcServer.GracefulStop()
+	<-proxyDone
 }
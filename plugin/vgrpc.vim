@@ -1,21 +1,68 @@
 let g:vgrpc_channel = ""
 
+" g:vgrpc_address is the address of the proxy, "unix:<path>" connects to
+" a Unix socket and "unix:" to the proxy's per-user default socket.
+let g:vgrpc_address = get(g:, "vgrpc_address", "localhost:7999")
+
+" VGRPC_route_rpc calls the handler of an rpc. An rpc without a handler
+" and a handler raising an exception are answered with an error.
 function! VGRPC_route_rpc(channel, msg) 
   echom "got rpc message " . a:msg["rpc"]
-  call g:VGRPC_router[a:msg["rpc"]](a:channel, a:msg)
+  if !has_key(g:VGRPC_router, a:msg["rpc"])
+    call rpc#error#Reply(a:channel, a:msg, "UNIMPLEMENTED", "no handler for rpc " . a:msg["rpc"])
+    return
+  endif
+  try
+    call g:VGRPC_router[a:msg["rpc"]](a:channel, a:msg)
+  catch
+    call rpc#error#ReplyException(a:channel, a:msg)
+  endtry
+endfun
+
+" s:Address resolves "unix:" to the per-user default socket, see
+" proxy.SocketDir.
+function! s:Address()
+  if g:vgrpc_address != "unix:"
+    return g:vgrpc_address
+  endif
+  if $XDG_RUNTIME_DIR != ""
+    let dir = $XDG_RUNTIME_DIR . "/vgrpc"
+  else
+    let dir = ($TMPDIR != "" ? $TMPDIR : "/tmp") . "/vgrpc-" . trim(system("id -u"))
+  endif
+  return "unix:" . dir . "/vim.sock"
 endfun
 
 function! s:VGRPC_start() 
-    let g:vgrpc_channel = ch_open("localhost:7999", {
-          \ "waittime": 0,
-          \ "callback": "VGRPC_route_rpc"
-          \})
+    let address = s:Address()
+    let options = {
+          \ "callback": "VGRPC_route_rpc",
+          \ "close_cb": "VGRPC_closed"
+          \}
+    " Vim rejects a waittime for Unix sockets.
+    if address !~ "^unix:"
+      let options["waittime"] = 0
+    endif
+    let g:vgrpc_channel = ch_open(address, options)
+endfun
+
+" VGRPC_closed drops the state registered over a closed channel.
+function! VGRPC_closed(channel)
+  call handlers#commands#Reset()
+  call handlers#keymaps#Reset()
+  call handlers#buffers#Reset()
 endfun
 
 function! s:VGRPC_stop() 
   call ch_close(g:vgrpc_channel)
+  call VGRPC_closed(g:vgrpc_channel)
 endfun
 
+augroup vgrpc_sessions
+  autocmd!
+  autocmd FocusGained * call handlers#sessions#Focused()
+augroup END
+
 command! -nargs=* VGRPCStart call s:VGRPC_start()
 command! -nargs=* VGRPCStop  call s:VGRPC_stop()
 
@@ -1,5 +1,41 @@
 let g:VGRPC_router = {
       \ "Ping": function("handlers#ping#Ping"),
+      \ "Session": function("handlers#sessions#Session"),
+      \ "EnvelopeRejected": function("handlers#channel#EnvelopeRejected"),
       \ "GetEnv": function("handlers#env#GetEnv"),
-      \ "RegisterCommand": function("handlers#commands#RegisterCommand")
+      \ "RegisterCommand": function("handlers#commands#RegisterCommand"),
+      \ "UnregisterCommand": function("handlers#commands#UnregisterCommand"),
+      \ "ExecCommand": function("handlers#commands#ExecCommand"),
+      \ "GetBufInfo": function("handlers#buffers#GetBufInfo"),
+      \ "GetLines": function("handlers#buffers#GetLines"),
+      \ "SetLines": function("handlers#buffers#SetLines"),
+      \ "InsertLines": function("handlers#buffers#InsertLines"),
+      \ "DeleteLines": function("handlers#buffers#DeleteLines"),
+      \ "WatchBuffer": function("handlers#buffers#WatchBuffer"),
+      \ "UnwatchBuffer": function("handlers#buffers#UnwatchBuffer"),
+      \ "EnableAutocmds": function("handlers#events#EnableAutocmds"),
+      \ "DisableAutocmds": function("handlers#events#DisableAutocmds"),
+      \ "Execute": function("handlers#editor#Execute"),
+      \ "Eval": function("handlers#editor#Eval"),
+      \ "ListTabpages": function("handlers#windows#ListTabpages"),
+      \ "ListWindows": function("handlers#windows#ListWindows"),
+      \ "GetCursor": function("handlers#windows#GetCursor"),
+      \ "SetCursor": function("handlers#windows#SetCursor"),
+      \ "SplitWindow": function("handlers#windows#SplitWindow"),
+      \ "CloseWindow": function("handlers#windows#CloseWindow"),
+      \ "FocusWindow": function("handlers#windows#FocusWindow"),
+      \ "OpenFile": function("handlers#windows#OpenFile"),
+      \ "Notify": function("handlers#ui#Notify"),
+      \ "OpenPopup": function("handlers#ui#OpenPopup"),
+      \ "ClosePopup": function("handlers#ui#ClosePopup"),
+      \ "SetList": function("handlers#quickfix#SetList"),
+      \ "GetList": function("handlers#quickfix#GetList"),
+      \ "OpenList": function("handlers#quickfix#OpenList"),
+      \ "CloseList": function("handlers#quickfix#CloseList"),
+      \ "PublishDiagnostics": function("handlers#diagnostics#PublishDiagnostics"),
+      \ "ListDiagnostics": function("handlers#diagnostics#ListDiagnostics"),
+      \ "RegisterCompletion": function("handlers#completion#RegisterCompletion"),
+      \ "UnregisterCompletion": function("handlers#completion#UnregisterCompletion"),
+      \ "RegisterKeymap": function("handlers#keymaps#RegisterKeymap"),
+      \ "UnregisterKeymap": function("handlers#keymaps#UnregisterKeymap")
       \ }
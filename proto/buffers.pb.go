@@ -25,6 +25,59 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type BufferEditResult_Status int32
+
+const (
+	BufferEditResult_APPLIED BufferEditResult_Status = 0
+	// the buffer's changedtick did not match the request's.
+	BufferEditResult_CONFLICT      BufferEditResult_Status = 1
+	BufferEditResult_NOT_FOUND     BufferEditResult_Status = 2
+	BufferEditResult_INVALID_RANGE BufferEditResult_Status = 3
+)
+
+// Enum value maps for BufferEditResult_Status.
+var (
+	BufferEditResult_Status_name = map[int32]string{
+		0: "APPLIED",
+		1: "CONFLICT",
+		2: "NOT_FOUND",
+		3: "INVALID_RANGE",
+	}
+	BufferEditResult_Status_value = map[string]int32{
+		"APPLIED":       0,
+		"CONFLICT":      1,
+		"NOT_FOUND":     2,
+		"INVALID_RANGE": 3,
+	}
+)
+
+func (x BufferEditResult_Status) Enum() *BufferEditResult_Status {
+	p := new(BufferEditResult_Status)
+	*p = x
+	return p
+}
+
+func (x BufferEditResult_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BufferEditResult_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_buffers_proto_enumTypes[0].Descriptor()
+}
+
+func (BufferEditResult_Status) Type() protoreflect.EnumType {
+	return &file_buffers_proto_enumTypes[0]
+}
+
+func (x BufferEditResult_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BufferEditResult_Status.Descriptor instead.
+func (BufferEditResult_Status) EnumDescriptor() ([]byte, []int) {
+	return file_buffers_proto_rawDescGZIP(), []int{11, 0}
+}
+
 // BufInfo is an informational structure describing a buffer
 // open in the current Vim session.
 type BufInfo struct {
@@ -312,18 +365,22 @@ func (x *GetBufInfoResponse) GetBuffers() []*BufInfo {
 	return nil
 }
 
-type BufInfo_Sign struct {
+// GetLinesRequest defines the GetLines rpc arguments.
+//
+// Line numbers are one-based and inclusive. An end of zero
+// refers to the last line of the buffer.
+type GetLinesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Lnum int64  `protobuf:"varint,2,opt,name=lnum,proto3" json:"lnum,omitempty"`
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Bufnr int64 `protobuf:"varint,1,opt,name=bufnr,proto3" json:"bufnr,omitempty"`
+	Start int64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64 `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
 }
 
-func (x *BufInfo_Sign) Reset() {
-	*x = BufInfo_Sign{}
+func (x *GetLinesRequest) Reset() {
+	*x = GetLinesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_buffers_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -331,13 +388,13 @@ func (x *BufInfo_Sign) Reset() {
 	}
 }
 
-func (x *BufInfo_Sign) String() string {
+func (x *GetLinesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BufInfo_Sign) ProtoMessage() {}
+func (*GetLinesRequest) ProtoMessage() {}
 
-func (x *BufInfo_Sign) ProtoReflect() protoreflect.Message {
+func (x *GetLinesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_buffers_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -349,178 +406,1307 @@ func (x *BufInfo_Sign) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BufInfo_Sign.ProtoReflect.Descriptor instead.
-func (*BufInfo_Sign) Descriptor() ([]byte, []int) {
-	return file_buffers_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use GetLinesRequest.ProtoReflect.Descriptor instead.
+func (*GetLinesRequest) Descriptor() ([]byte, []int) {
+	return file_buffers_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *BufInfo_Sign) GetId() string {
+func (x *GetLinesRequest) GetBufnr() int64 {
 	if x != nil {
-		return x.Id
+		return x.Bufnr
 	}
-	return ""
+	return 0
 }
 
-func (x *BufInfo_Sign) GetLnum() int64 {
+func (x *GetLinesRequest) GetStart() int64 {
 	if x != nil {
-		return x.Lnum
+		return x.Start
 	}
 	return 0
 }
 
-func (x *BufInfo_Sign) GetName() string {
+func (x *GetLinesRequest) GetEnd() int64 {
 	if x != nil {
-		return x.Name
+		return x.End
 	}
-	return ""
+	return 0
 }
 
-var File_buffers_proto protoreflect.FileDescriptor
+// GetLinesResponse defines the GetLines rpc response.
+type GetLinesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_buffers_proto_rawDesc = []byte{
-	0x0a, 0x0d, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x03, 0x0a, 0x07, 0x42, 0x75, 0x66, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x74, 0x69,
-	0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x64, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1b, 0x0a,
-	0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69,
-	0x73, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74,
-	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x04, 0x6c, 0x6e, 0x75, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x65,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x69, 0x67, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x75, 0x66, 0x49, 0x6e, 0x66, 0x6f,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x05, 0x73, 0x69, 0x67, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x77,
-	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x70, 0x75, 0x70, 0x73,
-	0x18, 0x0d, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x70, 0x75, 0x70, 0x73, 0x1a, 0x3e,
-	0x0a, 0x04, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6e, 0x75, 0x6d, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x6e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x53,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x75, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x62, 0x75, 0x66, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x48, 0x00, 0x52, 0x04, 0x62, 0x75, 0x66, 0x6e, 0x12, 0x1b, 0x0a, 0x08, 0x62, 0x75, 0x66,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x62,
-	0x75, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x75, 0x66, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x62, 0x75, 0x66,
-	0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x42, 0x75, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x62, 0x75, 0x66, 0x66,
-	0x65, 0x72, 0x73, 0x42, 0x28, 0x5a, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6c, 0x64, 0x65, 0x6c, 0x6f, 0x73, 0x73, 0x61, 0x2f, 0x76, 0x69, 0x6d, 0x2d, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Lines []string `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	// changedtick of the buffer the lines were read at.
+	ChangedTick int64 `protobuf:"varint,2,opt,name=changed_tick,json=changedTick,proto3" json:"changed_tick,omitempty"`
 }
 
-var (
-	file_buffers_proto_rawDescOnce sync.Once
-	file_buffers_proto_rawDescData = file_buffers_proto_rawDesc
-)
+func (x *GetLinesResponse) Reset() {
+	*x = GetLinesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buffers_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_buffers_proto_rawDescGZIP() []byte {
-	file_buffers_proto_rawDescOnce.Do(func() {
-		file_buffers_proto_rawDescData = protoimpl.X.CompressGZIP(file_buffers_proto_rawDescData)
-	})
-	return file_buffers_proto_rawDescData
+func (x *GetLinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_buffers_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_buffers_proto_goTypes = []interface{}{
-	(*BufInfo)(nil),            // 0: proto.BufInfo
-	(*GetBufInfoRequest)(nil),  // 1: proto.GetBufInfoRequest
-	(*GetBufInfoResponse)(nil), // 2: proto.GetBufInfoResponse
-	(*BufInfo_Sign)(nil),       // 3: proto.BufInfo.Sign
+func (*GetLinesResponse) ProtoMessage() {}
+
+func (x *GetLinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buffers_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_buffers_proto_depIdxs = []int32{
-	3, // 0: proto.BufInfo.signs:type_name -> proto.BufInfo.Sign
-	0, // 1: proto.GetBufInfoResponse.buffers:type_name -> proto.BufInfo
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+// Deprecated: Use GetLinesResponse.ProtoReflect.Descriptor instead.
+func (*GetLinesResponse) Descriptor() ([]byte, []int) {
+	return file_buffers_proto_rawDescGZIP(), []int{4}
 }
 
-func init() { file_buffers_proto_init() }
-func file_buffers_proto_init() {
-	if File_buffers_proto != nil {
-		return
+func (x *GetLinesResponse) GetLines() []string {
+	if x != nil {
+		return x.Lines
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_buffers_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BufInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_buffers_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBufInfoRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_buffers_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBufInfoResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return nil
+}
+
+func (x *GetLinesResponse) GetChangedTick() int64 {
+	if x != nil {
+		return x.ChangedTick
+	}
+	return 0
+}
+
+// SetLinesRequest defines the SetLines rpc arguments.
+//
+// Lines start through end, one-based and inclusive, are replaced
+// with the provided lines. An end of zero refers to the last line
+// of the buffer.
+//
+// The request is rejected if the buffer's changedtick no longer
+// matches changed_tick.
+type SetLinesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bufnr       int64    `protobuf:"varint,1,opt,name=bufnr,proto3" json:"bufnr,omitempty"`
+	ChangedTick int64    `protobuf:"varint,2,opt,name=changed_tick,json=changedTick,proto3" json:"changed_tick,omitempty"`
+	Start       int64    `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End         int64    `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	Lines       []string `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"`
+}
+
+func (x *SetLinesRequest) Reset() {
+	*x = SetLinesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buffers_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLinesRequest) ProtoMessage() {}
+
+func (x *SetLinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buffers_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_buffers_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BufInfo_Sign); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLinesRequest.ProtoReflect.Descriptor instead.
+func (*SetLinesRequest) Descriptor() ([]byte, []int) {
+	return file_buffers_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetLinesRequest) GetBufnr() int64 {
+	if x != nil {
+		return x.Bufnr
+	}
+	return 0
+}
+
+func (x *SetLinesRequest) GetChangedTick() int64 {
+	if x != nil {
+		return x.ChangedTick
+	}
+	return 0
+}
+
+func (x *SetLinesRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *SetLinesRequest) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *SetLinesRequest) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// SetLinesResponse defines the SetLines rpc response.
+type SetLinesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// changedtick of the buffer after the edit.
+	ChangedTick int64 `protobuf:"varint,1,opt,name=changed_tick,json=changedTick,proto3" json:"changed_tick,omitempty"`
+}
+
+func (x *SetLinesResponse) Reset() {
+	*x = SetLinesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buffers_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLinesResponse) ProtoMessage() {}
+
+func (x *SetLinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buffers_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	file_buffers_proto_msgTypes[1].OneofWrappers = []interface{}{
-		(*GetBufInfoRequest_Bufn)(nil),
-		(*GetBufInfoRequest_BufName)(nil),
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLinesResponse.ProtoReflect.Descriptor instead.
+func (*SetLinesResponse) Descriptor() ([]byte, []int) {
+	return file_buffers_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetLinesResponse) GetChangedTick() int64 {
+	if x != nil {
+		return x.ChangedTick
+	}
+	return 0
+}
+
+// InsertLinesRequest defines the InsertLines rpc arguments.
+//
+// Lines are inserted below lnum, a lnum of zero inserts
+// at the top of the buffer.
+//
+// The request is rejected if the buffer's changedtick no longer
+// matches changed_tick.
+type InsertLinesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bufnr       int64    `protobuf:"varint,1,opt,name=bufnr,proto3" json:"bufnr,omitempty"`
+	ChangedTick int64    `protobuf:"varint,2,opt,name=changed_tick,json=changedTick,proto3" json:"changed_tick,omitempty"`
+	Lnum        int64    `protobuf:"varint,3,opt,name=lnum,proto3" json:"lnum,omitempty"`
+	Lines       []string `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+}
+
+func (x *InsertLinesRequest) Reset() {
+	*x = InsertLinesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buffers_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InsertLinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertLinesRequest) ProtoMessage() {}
+
+func (x *InsertLinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buffers_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertLinesRequest.ProtoReflect.Descriptor instead.
+func (*InsertLinesRequest) Descriptor() ([]byte, []int) {
+	return file_buffers_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InsertLinesRequest) GetBufnr() int64 {
+	if x != nil {
+		return x.Bufnr
+	}
+	return 0
+}
+
+func (x *InsertLinesRequest) GetChangedTick() int64 {
+	if x != nil {
+		return x.ChangedTick
+	}
+	return 0
+}
+
+func (x *InsertLinesRequest) GetLnum() int64 {
+	if x != nil {
+		return x.Lnum
+	}
+	return 0
+}
+
+func (x *InsertLinesRequest) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// InsertLinesResponse defines the InsertLines rpc response.
+type InsertLinesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// changedtick of the buffer after the edit.
+	ChangedTick int64 `protobuf:"varint,1,opt,name=changed_tick,json=changedTick,proto3" json:"changed_tick,omitempty"`
+}
+
+func (x *InsertLinesResponse) Reset() {
+	*x = InsertLinesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buffers_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InsertLinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertLinesResponse) ProtoMessage() {}
+
+func (x *InsertLinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buffers_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertLinesResponse.ProtoReflect.Descriptor instead.
+func (*InsertLinesResponse) Descriptor() ([]byte, []int) {
+	return file_buffers_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InsertLinesResponse) GetChangedTick() int64 {
+	if x != nil {
+		return x.ChangedTick
+	}
+	return 0
+}
+
+// DeleteLinesRequest defines the DeleteLines rpc arguments.
+//
+// Lines start through end, one-based and inclusive, are deleted.
+// An end of zero refers to the last line of the buffer.
+//
+// The request is rejected if the buffer's changedtick no longer
+// matches changed_tick.
+type DeleteLinesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bufnr       int64 `protobuf:"varint,1,opt,name=bufnr,proto3" json:"bufnr,omitempty"`
+	ChangedTick int64 `protobuf:"varint,2,opt,name=changed_tick,json=changedTick,proto3" json:"changed_tick,omitempty"`
+	Start       int64 `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End         int64 `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *DeleteLinesRequest) Reset() {
+	*x = DeleteLinesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buffers_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLinesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLinesRequest) ProtoMessage() {}
+
+func (x *DeleteLinesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buffers_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLinesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLinesRequest) Descriptor() ([]byte, []int) {
+	return file_buffers_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteLinesRequest) GetBufnr() int64 {
+	if x != nil {
+		return x.Bufnr
+	}
+	return 0
+}
+
+func (x *DeleteLinesRequest) GetChangedTick() int64 {
+	if x != nil {
+		return x.ChangedTick
+	}
+	return 0
+}
+
+func (x *DeleteLinesRequest) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *DeleteLinesRequest) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// DeleteLinesResponse defines the DeleteLines rpc response.
+type DeleteLinesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// changedtick of the buffer after the edit.
+	ChangedTick int64 `protobuf:"varint,1,opt,name=changed_tick,json=changedTick,proto3" json:"changed_tick,omitempty"`
+}
+
+func (x *DeleteLinesResponse) Reset() {
+	*x = DeleteLinesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buffers_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLinesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLinesResponse) ProtoMessage() {}
+
+func (x *DeleteLinesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_buffers_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLinesResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLinesResponse) Descriptor() ([]byte, []int) {
+	return file_buffers_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteLinesResponse) GetChangedTick() int64 {
+	if x != nil {
+		return x.ChangedTick
+	}
+	return 0
+}
+
+// BufferEditResult is Vim's reply to any of the buffer
+// editing rpcs.
+type BufferEditResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status BufferEditResult_Status `protobuf:"varint,1,opt,name=status,proto3,enum=proto.BufferEditResult_Status" json:"status,omitempty"`
+	// changedtick of the buffer after the edit, or its current
+	// changedtick if the edit was not applied.
+	ChangedTick int64 `protobuf:"varint,2,opt,name=changed_tick,json=changedTick,proto3" json:"changed_tick,omitempty"`
+}
+
+func (x *BufferEditResult) Reset() {
+	*x = BufferEditResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buffers_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BufferEditResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BufferEditResult) ProtoMessage() {}
+
+func (x *BufferEditResult) ProtoReflect() protoreflect.Message {
+	mi := &file_buffers_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BufferEditResult.ProtoReflect.Descriptor instead.
+func (*BufferEditResult) Descriptor() ([]byte, []int) {
+	return file_buffers_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BufferEditResult) GetStatus() BufferEditResult_Status {
+	if x != nil {
+		return x.Status
+	}
+	return BufferEditResult_APPLIED
+}
+
+func (x *BufferEditResult) GetChangedTick() int64 {
+	if x != nil {
+		return x.ChangedTick
+	}
+	return 0
+}
+
+// WatchBufferRequest defines the WatchBuffer rpc arguments.
+type WatchBufferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bufnr int64 `protobuf:"varint,1,opt,name=bufnr,proto3" json:"bufnr,omitempty"`
+	// when true the stream begins with a BufferSnapshot of
+	// the buffer's contents.
+	Snapshot bool `protobuf:"varint,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *WatchBufferRequest) Reset() {
+	*x = WatchBufferRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buffers_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchBufferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBufferRequest) ProtoMessage() {}
+
+func (x *WatchBufferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_buffers_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBufferRequest.ProtoReflect.Descriptor instead.
+func (*WatchBufferRequest) Descriptor() ([]byte, []int) {
+	return file_buffers_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchBufferRequest) GetBufnr() int64 {
+	if x != nil {
+		return x.Bufnr
+	}
+	return 0
+}
+
+func (x *WatchBufferRequest) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
+}
+
+// BufferEvent is a OneOf holding the messages streamed
+// to a buffer's watchers.
+type BufferEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*BufferEvent_Snapshot
+	//	*BufferEvent_Change
+	Event isBufferEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *BufferEvent) Reset() {
+	*x = BufferEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buffers_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BufferEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BufferEvent) ProtoMessage() {}
+
+func (x *BufferEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_buffers_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BufferEvent.ProtoReflect.Descriptor instead.
+func (*BufferEvent) Descriptor() ([]byte, []int) {
+	return file_buffers_proto_rawDescGZIP(), []int{13}
+}
+
+func (m *BufferEvent) GetEvent() isBufferEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *BufferEvent) GetSnapshot() *BufferSnapshot {
+	if x, ok := x.GetEvent().(*BufferEvent_Snapshot); ok {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *BufferEvent) GetChange() *BufferChange {
+	if x, ok := x.GetEvent().(*BufferEvent_Change); ok {
+		return x.Change
+	}
+	return nil
+}
+
+type isBufferEvent_Event interface {
+	isBufferEvent_Event()
+}
+
+type BufferEvent_Snapshot struct {
+	Snapshot *BufferSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3,oneof"`
+}
+
+type BufferEvent_Change struct {
+	Change *BufferChange `protobuf:"bytes,2,opt,name=change,proto3,oneof"`
+}
+
+func (*BufferEvent_Snapshot) isBufferEvent_Event() {}
+
+func (*BufferEvent_Change) isBufferEvent_Event() {}
+
+// BufferSnapshot holds the full contents of a buffer.
+type BufferSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bufnr       int64    `protobuf:"varint,1,opt,name=bufnr,proto3" json:"bufnr,omitempty"`
+	Lines       []string `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	ChangedTick int64    `protobuf:"varint,3,opt,name=changed_tick,json=changedTick,proto3" json:"changed_tick,omitempty"`
+}
+
+func (x *BufferSnapshot) Reset() {
+	*x = BufferSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buffers_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BufferSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BufferSnapshot) ProtoMessage() {}
+
+func (x *BufferSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_buffers_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BufferSnapshot.ProtoReflect.Descriptor instead.
+func (*BufferSnapshot) Descriptor() ([]byte, []int) {
+	return file_buffers_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BufferSnapshot) GetBufnr() int64 {
+	if x != nil {
+		return x.Bufnr
+	}
+	return 0
+}
+
+func (x *BufferSnapshot) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *BufferSnapshot) GetChangedTick() int64 {
+	if x != nil {
+		return x.ChangedTick
+	}
+	return 0
+}
+
+// BufferChange describes an edit made to a buffer, as reported
+// by Vim's listener_add().
+//
+// Lines start up to but not including end were replaced by
+// the provided lines. Added is the number of lines added, negative
+// when lines were deleted.
+type BufferChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bufnr       int64    `protobuf:"varint,1,opt,name=bufnr,proto3" json:"bufnr,omitempty"`
+	Start       int64    `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End         int64    `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	Added       int64    `protobuf:"varint,4,opt,name=added,proto3" json:"added,omitempty"`
+	Lines       []string `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"`
+	ChangedTick int64    `protobuf:"varint,6,opt,name=changed_tick,json=changedTick,proto3" json:"changed_tick,omitempty"`
+}
+
+func (x *BufferChange) Reset() {
+	*x = BufferChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buffers_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BufferChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BufferChange) ProtoMessage() {}
+
+func (x *BufferChange) ProtoReflect() protoreflect.Message {
+	mi := &file_buffers_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BufferChange.ProtoReflect.Descriptor instead.
+func (*BufferChange) Descriptor() ([]byte, []int) {
+	return file_buffers_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BufferChange) GetBufnr() int64 {
+	if x != nil {
+		return x.Bufnr
+	}
+	return 0
+}
+
+func (x *BufferChange) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *BufferChange) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *BufferChange) GetAdded() int64 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *BufferChange) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *BufferChange) GetChangedTick() int64 {
+	if x != nil {
+		return x.ChangedTick
+	}
+	return 0
+}
+
+type BufInfo_Sign struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Lnum int64  `protobuf:"varint,2,opt,name=lnum,proto3" json:"lnum,omitempty"`
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *BufInfo_Sign) Reset() {
+	*x = BufInfo_Sign{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_buffers_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BufInfo_Sign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BufInfo_Sign) ProtoMessage() {}
+
+func (x *BufInfo_Sign) ProtoReflect() protoreflect.Message {
+	mi := &file_buffers_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BufInfo_Sign.ProtoReflect.Descriptor instead.
+func (*BufInfo_Sign) Descriptor() ([]byte, []int) {
+	return file_buffers_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *BufInfo_Sign) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BufInfo_Sign) GetLnum() int64 {
+	if x != nil {
+		return x.Lnum
+	}
+	return 0
+}
+
+func (x *BufInfo_Sign) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_buffers_proto protoreflect.FileDescriptor
+
+var file_buffers_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5, 0x03, 0x0a, 0x07, 0x42, 0x75, 0x66, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x74, 0x69,
+	0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x6c, 0x6e, 0x75, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x69, 0x67, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x75, 0x66, 0x49, 0x6e, 0x66, 0x6f,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x05, 0x73, 0x69, 0x67, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x70, 0x75, 0x70, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x70, 0x75, 0x70, 0x73, 0x1a, 0x3e,
+	0x0a, 0x04, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6e, 0x75, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x6e, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x53,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x75, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x62, 0x75, 0x66, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x04, 0x62, 0x75, 0x66, 0x6e, 0x12, 0x1b, 0x0a, 0x08, 0x62, 0x75, 0x66,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x62,
+	0x75, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x75, 0x66, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x62, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x42, 0x75, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x62, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x73, 0x22, 0x4f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x65, 0x6e, 0x64, 0x22, 0x4b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x54, 0x69, 0x63,
+	0x6b, 0x22, 0x88, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x10,
+	0x53, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x54,
+	0x69, 0x63, 0x6b, 0x22, 0x77, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4c, 0x69, 0x6e,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x66,
+	0x6e, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x54, 0x69,
+	0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x6c, 0x6e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x38, 0x0a, 0x13,
+	0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x74,
+	0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x22, 0x75, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x75, 0x66, 0x6e, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75, 0x66,
+	0x6e, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x74, 0x69,
+	0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65,
+	0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x38, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x22, 0xb4, 0x01, 0x0a, 0x10, 0x42, 0x75, 0x66, 0x66,
+	0x65, 0x72, 0x45, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x36, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x45, 0x64, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x22, 0x45, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x03, 0x22, 0x46,
+	0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x7a, 0x0a, 0x0b, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00,
+	0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2d, 0x0a, 0x06, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x5f, 0x0a, 0x0e, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x54,
+	0x69, 0x63, 0x6b, 0x22, 0x9b, 0x01, 0x0a, 0x0c, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65,
+	0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x54, 0x69, 0x63,
+	0x6b, 0x42, 0x28, 0x5a, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6c, 0x64, 0x65, 0x6c, 0x6f, 0x73, 0x73, 0x61, 0x2f, 0x76, 0x69, 0x6d, 0x2d, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x69, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_buffers_proto_rawDescOnce sync.Once
+	file_buffers_proto_rawDescData = file_buffers_proto_rawDesc
+)
+
+func file_buffers_proto_rawDescGZIP() []byte {
+	file_buffers_proto_rawDescOnce.Do(func() {
+		file_buffers_proto_rawDescData = protoimpl.X.CompressGZIP(file_buffers_proto_rawDescData)
+	})
+	return file_buffers_proto_rawDescData
+}
+
+var file_buffers_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_buffers_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_buffers_proto_goTypes = []interface{}{
+	(BufferEditResult_Status)(0), // 0: proto.BufferEditResult.Status
+	(*BufInfo)(nil),              // 1: proto.BufInfo
+	(*GetBufInfoRequest)(nil),    // 2: proto.GetBufInfoRequest
+	(*GetBufInfoResponse)(nil),   // 3: proto.GetBufInfoResponse
+	(*GetLinesRequest)(nil),      // 4: proto.GetLinesRequest
+	(*GetLinesResponse)(nil),     // 5: proto.GetLinesResponse
+	(*SetLinesRequest)(nil),      // 6: proto.SetLinesRequest
+	(*SetLinesResponse)(nil),     // 7: proto.SetLinesResponse
+	(*InsertLinesRequest)(nil),   // 8: proto.InsertLinesRequest
+	(*InsertLinesResponse)(nil),  // 9: proto.InsertLinesResponse
+	(*DeleteLinesRequest)(nil),   // 10: proto.DeleteLinesRequest
+	(*DeleteLinesResponse)(nil),  // 11: proto.DeleteLinesResponse
+	(*BufferEditResult)(nil),     // 12: proto.BufferEditResult
+	(*WatchBufferRequest)(nil),   // 13: proto.WatchBufferRequest
+	(*BufferEvent)(nil),          // 14: proto.BufferEvent
+	(*BufferSnapshot)(nil),       // 15: proto.BufferSnapshot
+	(*BufferChange)(nil),         // 16: proto.BufferChange
+	(*BufInfo_Sign)(nil),         // 17: proto.BufInfo.Sign
+}
+var file_buffers_proto_depIdxs = []int32{
+	17, // 0: proto.BufInfo.signs:type_name -> proto.BufInfo.Sign
+	1,  // 1: proto.GetBufInfoResponse.buffers:type_name -> proto.BufInfo
+	0,  // 2: proto.BufferEditResult.status:type_name -> proto.BufferEditResult.Status
+	15, // 3: proto.BufferEvent.snapshot:type_name -> proto.BufferSnapshot
+	16, // 4: proto.BufferEvent.change:type_name -> proto.BufferChange
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_buffers_proto_init() }
+func file_buffers_proto_init() {
+	if File_buffers_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_buffers_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BufInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buffers_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBufInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buffers_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBufInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buffers_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLinesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buffers_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLinesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buffers_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLinesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buffers_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLinesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buffers_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertLinesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buffers_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsertLinesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buffers_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLinesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buffers_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteLinesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buffers_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BufferEditResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buffers_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchBufferRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buffers_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BufferEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buffers_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BufferSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buffers_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BufferChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_buffers_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BufInfo_Sign); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_buffers_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*GetBufInfoRequest_Bufn)(nil),
+		(*GetBufInfoRequest_BufName)(nil),
+	}
+	file_buffers_proto_msgTypes[13].OneofWrappers = []interface{}{
+		(*BufferEvent_Snapshot)(nil),
+		(*BufferEvent_Change)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_buffers_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_buffers_proto_goTypes,
 		DependencyIndexes: file_buffers_proto_depIdxs,
+		EnumInfos:         file_buffers_proto_enumTypes,
 		MessageInfos:      file_buffers_proto_msgTypes,
 	}.Build()
 	File_buffers_proto = out.File
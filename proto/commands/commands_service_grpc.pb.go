@@ -18,6 +18,10 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CommandsClient interface {
 	RegisterCommand(ctx context.Context, in *RegisterCommandRequest, opts ...grpc.CallOption) (Commands_RegisterCommandClient, error)
+	ListCommands(ctx context.Context, in *ListCommandsRequest, opts ...grpc.CallOption) (*ListCommandsResponse, error)
+	UnregisterCommand(ctx context.Context, in *UnregisterCommandRequest, opts ...grpc.CallOption) (*UnregisterCommandResponse, error)
+	ExecCommand(ctx context.Context, in *ExecCommandRequest, opts ...grpc.CallOption) (*ExecCommandResponse, error)
+	CompleteCommand(ctx context.Context, in *CompleteCommandRequest, opts ...grpc.CallOption) (*CompleteCommandResponse, error)
 }
 
 type commandsClient struct {
@@ -60,11 +64,51 @@ func (x *commandsRegisterCommandClient) Recv() (*CommandEvent, error) {
 	return m, nil
 }
 
+func (c *commandsClient) ListCommands(ctx context.Context, in *ListCommandsRequest, opts ...grpc.CallOption) (*ListCommandsResponse, error) {
+	out := new(ListCommandsResponse)
+	err := c.cc.Invoke(ctx, "/commands.Commands/ListCommands", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandsClient) UnregisterCommand(ctx context.Context, in *UnregisterCommandRequest, opts ...grpc.CallOption) (*UnregisterCommandResponse, error) {
+	out := new(UnregisterCommandResponse)
+	err := c.cc.Invoke(ctx, "/commands.Commands/UnregisterCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandsClient) ExecCommand(ctx context.Context, in *ExecCommandRequest, opts ...grpc.CallOption) (*ExecCommandResponse, error) {
+	out := new(ExecCommandResponse)
+	err := c.cc.Invoke(ctx, "/commands.Commands/ExecCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commandsClient) CompleteCommand(ctx context.Context, in *CompleteCommandRequest, opts ...grpc.CallOption) (*CompleteCommandResponse, error) {
+	out := new(CompleteCommandResponse)
+	err := c.cc.Invoke(ctx, "/commands.Commands/CompleteCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommandsServer is the server API for Commands service.
 // All implementations must embed UnimplementedCommandsServer
 // for forward compatibility
 type CommandsServer interface {
 	RegisterCommand(*RegisterCommandRequest, Commands_RegisterCommandServer) error
+	ListCommands(context.Context, *ListCommandsRequest) (*ListCommandsResponse, error)
+	UnregisterCommand(context.Context, *UnregisterCommandRequest) (*UnregisterCommandResponse, error)
+	ExecCommand(context.Context, *ExecCommandRequest) (*ExecCommandResponse, error)
+	CompleteCommand(context.Context, *CompleteCommandRequest) (*CompleteCommandResponse, error)
 	mustEmbedUnimplementedCommandsServer()
 }
 
@@ -75,6 +119,18 @@ type UnimplementedCommandsServer struct {
 func (UnimplementedCommandsServer) RegisterCommand(*RegisterCommandRequest, Commands_RegisterCommandServer) error {
 	return status.Errorf(codes.Unimplemented, "method RegisterCommand not implemented")
 }
+func (UnimplementedCommandsServer) ListCommands(context.Context, *ListCommandsRequest) (*ListCommandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommands not implemented")
+}
+func (UnimplementedCommandsServer) UnregisterCommand(context.Context, *UnregisterCommandRequest) (*UnregisterCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterCommand not implemented")
+}
+func (UnimplementedCommandsServer) ExecCommand(context.Context, *ExecCommandRequest) (*ExecCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecCommand not implemented")
+}
+func (UnimplementedCommandsServer) CompleteCommand(context.Context, *CompleteCommandRequest) (*CompleteCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteCommand not implemented")
+}
 func (UnimplementedCommandsServer) mustEmbedUnimplementedCommandsServer() {}
 
 // UnsafeCommandsServer may be embedded to opt out of forward compatibility for this service.
@@ -109,10 +165,99 @@ func (x *commandsRegisterCommandServer) Send(m *CommandEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Commands_ListCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCommandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandsServer).ListCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commands.Commands/ListCommands",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandsServer).ListCommands(ctx, req.(*ListCommandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Commands_UnregisterCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandsServer).UnregisterCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commands.Commands/UnregisterCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandsServer).UnregisterCommand(ctx, req.(*UnregisterCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Commands_ExecCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandsServer).ExecCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commands.Commands/ExecCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandsServer).ExecCommand(ctx, req.(*ExecCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Commands_CompleteCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommandsServer).CompleteCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/commands.Commands/CompleteCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommandsServer).CompleteCommand(ctx, req.(*CompleteCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Commands_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "commands.Commands",
 	HandlerType: (*CommandsServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCommands",
+			Handler:    _Commands_ListCommands_Handler,
+		},
+		{
+			MethodName: "UnregisterCommand",
+			Handler:    _Commands_UnregisterCommand_Handler,
+		},
+		{
+			MethodName: "ExecCommand",
+			Handler:    _Commands_ExecCommand_Handler,
+		},
+		{
+			MethodName: "CompleteCommand",
+			Handler:    _Commands_CompleteCommand_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "RegisterCommand",
@@ -0,0 +1,781 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: completion/completion.proto
+
+package completion
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// RegisterCompletionRequest asks vim-grpc.vim to register the extension
+// as a completion source for the described filetypes.
+type RegisterCompletionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Extension string   `protobuf:"bytes,1,opt,name=extension,proto3" json:"extension,omitempty"`
+	Filetypes []string `protobuf:"bytes,2,rep,name=filetypes,proto3" json:"filetypes,omitempty"`
+	// milliseconds Vim waits for the extension's candidates, zero for
+	// the default of 500ms.
+	TimeoutMs int64 `protobuf:"varint,3,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+}
+
+func (x *RegisterCompletionRequest) Reset() {
+	*x = RegisterCompletionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_completion_completion_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterCompletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterCompletionRequest) ProtoMessage() {}
+
+func (x *RegisterCompletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_completion_completion_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterCompletionRequest.ProtoReflect.Descriptor instead.
+func (*RegisterCompletionRequest) Descriptor() ([]byte, []int) {
+	return file_completion_completion_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RegisterCompletionRequest) GetExtension() string {
+	if x != nil {
+		return x.Extension
+	}
+	return ""
+}
+
+func (x *RegisterCompletionRequest) GetFiletypes() []string {
+	if x != nil {
+		return x.Filetypes
+	}
+	return nil
+}
+
+func (x *RegisterCompletionRequest) GetTimeoutMs() int64 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+// CompletionEvent is a OneOf holding sub-message types affiliated
+// with an extension's completion registration.
+type CompletionEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*CompletionEvent_Registration
+	//	*CompletionEvent_Request
+	Event isCompletionEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *CompletionEvent) Reset() {
+	*x = CompletionEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_completion_completion_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompletionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletionEvent) ProtoMessage() {}
+
+func (x *CompletionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_completion_completion_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletionEvent.ProtoReflect.Descriptor instead.
+func (*CompletionEvent) Descriptor() ([]byte, []int) {
+	return file_completion_completion_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *CompletionEvent) GetEvent() isCompletionEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *CompletionEvent) GetRegistration() *CompletionRegistration {
+	if x, ok := x.GetEvent().(*CompletionEvent_Registration); ok {
+		return x.Registration
+	}
+	return nil
+}
+
+func (x *CompletionEvent) GetRequest() *CompletionRequest {
+	if x, ok := x.GetEvent().(*CompletionEvent_Request); ok {
+		return x.Request
+	}
+	return nil
+}
+
+type isCompletionEvent_Event interface {
+	isCompletionEvent_Event()
+}
+
+type CompletionEvent_Registration struct {
+	Registration *CompletionRegistration `protobuf:"bytes,1,opt,name=registration,proto3,oneof"`
+}
+
+type CompletionEvent_Request struct {
+	Request *CompletionRequest `protobuf:"bytes,2,opt,name=request,proto3,oneof"`
+}
+
+func (*CompletionEvent_Registration) isCompletionEvent_Event() {}
+
+func (*CompletionEvent_Request) isCompletionEvent_Event() {}
+
+type CompletionRegistration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Registered bool   `protobuf:"varint,1,opt,name=registered,proto3" json:"registered,omitempty"`
+	Reason     string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *CompletionRegistration) Reset() {
+	*x = CompletionRegistration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_completion_completion_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompletionRegistration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletionRegistration) ProtoMessage() {}
+
+func (x *CompletionRegistration) ProtoReflect() protoreflect.Message {
+	mi := &file_completion_completion_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletionRegistration.ProtoReflect.Descriptor instead.
+func (*CompletionRegistration) Descriptor() ([]byte, []int) {
+	return file_completion_completion_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CompletionRegistration) GetRegistered() bool {
+	if x != nil {
+		return x.Registered
+	}
+	return false
+}
+
+func (x *CompletionRegistration) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// CompletionRequest asks the extension for completion candidates,
+// it must be answered with a ProvideCompletions rpc carrying its id.
+type CompletionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Bufnr    int64  `protobuf:"varint,2,opt,name=bufnr,proto3" json:"bufnr,omitempty"`
+	Filetype string `protobuf:"bytes,3,opt,name=filetype,proto3" json:"filetype,omitempty"`
+	// cursor position, col is the one-based byte column the completed
+	// word starts at.
+	Lnum int64 `protobuf:"varint,4,opt,name=lnum,proto3" json:"lnum,omitempty"`
+	Col  int64 `protobuf:"varint,5,opt,name=col,proto3" json:"col,omitempty"`
+	// the text being completed.
+	Base string `protobuf:"bytes,6,opt,name=base,proto3" json:"base,omitempty"`
+	// the current line.
+	Line string `protobuf:"bytes,7,opt,name=line,proto3" json:"line,omitempty"`
+}
+
+func (x *CompletionRequest) Reset() {
+	*x = CompletionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_completion_completion_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletionRequest) ProtoMessage() {}
+
+func (x *CompletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_completion_completion_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletionRequest.ProtoReflect.Descriptor instead.
+func (*CompletionRequest) Descriptor() ([]byte, []int) {
+	return file_completion_completion_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CompletionRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CompletionRequest) GetBufnr() int64 {
+	if x != nil {
+		return x.Bufnr
+	}
+	return 0
+}
+
+func (x *CompletionRequest) GetFiletype() string {
+	if x != nil {
+		return x.Filetype
+	}
+	return ""
+}
+
+func (x *CompletionRequest) GetLnum() int64 {
+	if x != nil {
+		return x.Lnum
+	}
+	return 0
+}
+
+func (x *CompletionRequest) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *CompletionRequest) GetBase() string {
+	if x != nil {
+		return x.Base
+	}
+	return ""
+}
+
+func (x *CompletionRequest) GetLine() string {
+	if x != nil {
+		return x.Line
+	}
+	return ""
+}
+
+// CompletionItem is a completion candidate, see Vim's complete-items.
+type CompletionItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Word string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Abbr string `protobuf:"bytes,2,opt,name=abbr,proto3" json:"abbr,omitempty"`
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Menu string `protobuf:"bytes,4,opt,name=menu,proto3" json:"menu,omitempty"`
+	Info string `protobuf:"bytes,5,opt,name=info,proto3" json:"info,omitempty"`
+}
+
+func (x *CompletionItem) Reset() {
+	*x = CompletionItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_completion_completion_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompletionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletionItem) ProtoMessage() {}
+
+func (x *CompletionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_completion_completion_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletionItem.ProtoReflect.Descriptor instead.
+func (*CompletionItem) Descriptor() ([]byte, []int) {
+	return file_completion_completion_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CompletionItem) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *CompletionItem) GetAbbr() string {
+	if x != nil {
+		return x.Abbr
+	}
+	return ""
+}
+
+func (x *CompletionItem) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CompletionItem) GetMenu() string {
+	if x != nil {
+		return x.Menu
+	}
+	return ""
+}
+
+func (x *CompletionItem) GetInfo() string {
+	if x != nil {
+		return x.Info
+	}
+	return ""
+}
+
+// ProvideCompletionsRequest defines the ProvideCompletions rpc arguments.
+type ProvideCompletionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the CompletionRequest answered.
+	Id    int64             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Items []*CompletionItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ProvideCompletionsRequest) Reset() {
+	*x = ProvideCompletionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_completion_completion_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProvideCompletionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvideCompletionsRequest) ProtoMessage() {}
+
+func (x *ProvideCompletionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_completion_completion_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvideCompletionsRequest.ProtoReflect.Descriptor instead.
+func (*ProvideCompletionsRequest) Descriptor() ([]byte, []int) {
+	return file_completion_completion_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProvideCompletionsRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProvideCompletionsRequest) GetItems() []*CompletionItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// ProvideCompletionsResponse defines the ProvideCompletions rpc response.
+type ProvideCompletionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ProvideCompletionsResponse) Reset() {
+	*x = ProvideCompletionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_completion_completion_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProvideCompletionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvideCompletionsResponse) ProtoMessage() {}
+
+func (x *ProvideCompletionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_completion_completion_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvideCompletionsResponse.ProtoReflect.Descriptor instead.
+func (*ProvideCompletionsResponse) Descriptor() ([]byte, []int) {
+	return file_completion_completion_proto_rawDescGZIP(), []int{6}
+}
+
+// CompletionReply is the proxy's reply to Vim's completion request.
+type CompletionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*CompletionItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *CompletionReply) Reset() {
+	*x = CompletionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_completion_completion_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompletionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletionReply) ProtoMessage() {}
+
+func (x *CompletionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_completion_completion_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletionReply.ProtoReflect.Descriptor instead.
+func (*CompletionReply) Descriptor() ([]byte, []int) {
+	return file_completion_completion_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CompletionReply) GetItems() []*CompletionItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_completion_completion_proto protoreflect.FileDescriptor
+
+var file_completion_completion_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x19, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d,
+	0x73, 0x22, 0x9f, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x39, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48,
+	0x00, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x22, 0x50, 0x0a, 0x16, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
+	0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xa3, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62,
+	0x75, 0x66, 0x6e, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75, 0x66, 0x6e,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x6e, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x6e, 0x75,
+	0x6d, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x74, 0x0a, 0x0e, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a,
+	0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x62, 0x62, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x61, 0x62, 0x62, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6e,
+	0x75, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x12, 0x12, 0x0a,
+	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x22, 0x5d, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x22, 0x1c, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x43,
+	0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x30, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6c, 0x64, 0x65, 0x6c, 0x6f, 0x73, 0x73, 0x61, 0x2f, 0x76, 0x69, 0x6d, 0x2d, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_completion_completion_proto_rawDescOnce sync.Once
+	file_completion_completion_proto_rawDescData = file_completion_completion_proto_rawDesc
+)
+
+func file_completion_completion_proto_rawDescGZIP() []byte {
+	file_completion_completion_proto_rawDescOnce.Do(func() {
+		file_completion_completion_proto_rawDescData = protoimpl.X.CompressGZIP(file_completion_completion_proto_rawDescData)
+	})
+	return file_completion_completion_proto_rawDescData
+}
+
+var file_completion_completion_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_completion_completion_proto_goTypes = []interface{}{
+	(*RegisterCompletionRequest)(nil),  // 0: completion.RegisterCompletionRequest
+	(*CompletionEvent)(nil),            // 1: completion.CompletionEvent
+	(*CompletionRegistration)(nil),     // 2: completion.CompletionRegistration
+	(*CompletionRequest)(nil),          // 3: completion.CompletionRequest
+	(*CompletionItem)(nil),             // 4: completion.CompletionItem
+	(*ProvideCompletionsRequest)(nil),  // 5: completion.ProvideCompletionsRequest
+	(*ProvideCompletionsResponse)(nil), // 6: completion.ProvideCompletionsResponse
+	(*CompletionReply)(nil),            // 7: completion.CompletionReply
+}
+var file_completion_completion_proto_depIdxs = []int32{
+	2, // 0: completion.CompletionEvent.registration:type_name -> completion.CompletionRegistration
+	3, // 1: completion.CompletionEvent.request:type_name -> completion.CompletionRequest
+	4, // 2: completion.ProvideCompletionsRequest.items:type_name -> completion.CompletionItem
+	4, // 3: completion.CompletionReply.items:type_name -> completion.CompletionItem
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_completion_completion_proto_init() }
+func file_completion_completion_proto_init() {
+	if File_completion_completion_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_completion_completion_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterCompletionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_completion_completion_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompletionEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_completion_completion_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompletionRegistration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_completion_completion_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompletionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_completion_completion_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompletionItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_completion_completion_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvideCompletionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_completion_completion_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProvideCompletionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_completion_completion_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompletionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_completion_completion_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*CompletionEvent_Registration)(nil),
+		(*CompletionEvent_Request)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_completion_completion_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_completion_completion_proto_goTypes,
+		DependencyIndexes: file_completion_completion_proto_depIdxs,
+		MessageInfos:      file_completion_completion_proto_msgTypes,
+	}.Build()
+	File_completion_completion_proto = out.File
+	file_completion_completion_proto_rawDesc = nil
+	file_completion_completion_proto_goTypes = nil
+	file_completion_completion_proto_depIdxs = nil
+}
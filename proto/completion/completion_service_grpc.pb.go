@@ -0,0 +1,161 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package completion
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// CompletionClient is the client API for Completion service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CompletionClient interface {
+	RegisterCompletion(ctx context.Context, in *RegisterCompletionRequest, opts ...grpc.CallOption) (Completion_RegisterCompletionClient, error)
+	ProvideCompletions(ctx context.Context, in *ProvideCompletionsRequest, opts ...grpc.CallOption) (*ProvideCompletionsResponse, error)
+}
+
+type completionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCompletionClient(cc grpc.ClientConnInterface) CompletionClient {
+	return &completionClient{cc}
+}
+
+func (c *completionClient) RegisterCompletion(ctx context.Context, in *RegisterCompletionRequest, opts ...grpc.CallOption) (Completion_RegisterCompletionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Completion_serviceDesc.Streams[0], "/completion.Completion/RegisterCompletion", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &completionRegisterCompletionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Completion_RegisterCompletionClient interface {
+	Recv() (*CompletionEvent, error)
+	grpc.ClientStream
+}
+
+type completionRegisterCompletionClient struct {
+	grpc.ClientStream
+}
+
+func (x *completionRegisterCompletionClient) Recv() (*CompletionEvent, error) {
+	m := new(CompletionEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *completionClient) ProvideCompletions(ctx context.Context, in *ProvideCompletionsRequest, opts ...grpc.CallOption) (*ProvideCompletionsResponse, error) {
+	out := new(ProvideCompletionsResponse)
+	err := c.cc.Invoke(ctx, "/completion.Completion/ProvideCompletions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CompletionServer is the server API for Completion service.
+// All implementations must embed UnimplementedCompletionServer
+// for forward compatibility
+type CompletionServer interface {
+	RegisterCompletion(*RegisterCompletionRequest, Completion_RegisterCompletionServer) error
+	ProvideCompletions(context.Context, *ProvideCompletionsRequest) (*ProvideCompletionsResponse, error)
+	mustEmbedUnimplementedCompletionServer()
+}
+
+// UnimplementedCompletionServer must be embedded to have forward compatible implementations.
+type UnimplementedCompletionServer struct {
+}
+
+func (UnimplementedCompletionServer) RegisterCompletion(*RegisterCompletionRequest, Completion_RegisterCompletionServer) error {
+	return status.Errorf(codes.Unimplemented, "method RegisterCompletion not implemented")
+}
+func (UnimplementedCompletionServer) ProvideCompletions(context.Context, *ProvideCompletionsRequest) (*ProvideCompletionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProvideCompletions not implemented")
+}
+func (UnimplementedCompletionServer) mustEmbedUnimplementedCompletionServer() {}
+
+// UnsafeCompletionServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CompletionServer will
+// result in compilation errors.
+type UnsafeCompletionServer interface {
+	mustEmbedUnimplementedCompletionServer()
+}
+
+func RegisterCompletionServer(s grpc.ServiceRegistrar, srv CompletionServer) {
+	s.RegisterService(&_Completion_serviceDesc, srv)
+}
+
+func _Completion_RegisterCompletion_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RegisterCompletionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CompletionServer).RegisterCompletion(m, &completionRegisterCompletionServer{stream})
+}
+
+type Completion_RegisterCompletionServer interface {
+	Send(*CompletionEvent) error
+	grpc.ServerStream
+}
+
+type completionRegisterCompletionServer struct {
+	grpc.ServerStream
+}
+
+func (x *completionRegisterCompletionServer) Send(m *CompletionEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Completion_ProvideCompletions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProvideCompletionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompletionServer).ProvideCompletions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/completion.Completion/ProvideCompletions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompletionServer).ProvideCompletions(ctx, req.(*ProvideCompletionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Completion_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "completion.Completion",
+	HandlerType: (*CompletionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProvideCompletions",
+			Handler:    _Completion_ProvideCompletions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RegisterCompletion",
+			Handler:       _Completion_RegisterCompletion_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "completion/completion_service.proto",
+}
@@ -0,0 +1,779 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: diagnostics/diagnostics.proto
+
+package diagnostics
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Severity int32
+
+const (
+	Severity_ERROR   Severity = 0
+	Severity_WARNING Severity = 1
+	Severity_INFO    Severity = 2
+	Severity_HINT    Severity = 3
+)
+
+// Enum value maps for Severity.
+var (
+	Severity_name = map[int32]string{
+		0: "ERROR",
+		1: "WARNING",
+		2: "INFO",
+		3: "HINT",
+	}
+	Severity_value = map[string]int32{
+		"ERROR":   0,
+		"WARNING": 1,
+		"INFO":    2,
+		"HINT":    3,
+	}
+)
+
+func (x Severity) Enum() *Severity {
+	p := new(Severity)
+	*p = x
+	return p
+}
+
+func (x Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_diagnostics_diagnostics_proto_enumTypes[0].Descriptor()
+}
+
+func (Severity) Type() protoreflect.EnumType {
+	return &file_diagnostics_diagnostics_proto_enumTypes[0]
+}
+
+func (x Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Severity.Descriptor instead.
+func (Severity) EnumDescriptor() ([]byte, []int) {
+	return file_diagnostics_diagnostics_proto_rawDescGZIP(), []int{0}
+}
+
+// Range is a span of text in a buffer.
+//
+// Lines and columns are one-based, the end column is exclusive.
+// An end_lnum of zero refers to start_lnum and an end_col of zero
+// to the end of the line.
+type Range struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartLnum int64 `protobuf:"varint,1,opt,name=start_lnum,json=startLnum,proto3" json:"start_lnum,omitempty"`
+	StartCol  int64 `protobuf:"varint,2,opt,name=start_col,json=startCol,proto3" json:"start_col,omitempty"`
+	EndLnum   int64 `protobuf:"varint,3,opt,name=end_lnum,json=endLnum,proto3" json:"end_lnum,omitempty"`
+	EndCol    int64 `protobuf:"varint,4,opt,name=end_col,json=endCol,proto3" json:"end_col,omitempty"`
+}
+
+func (x *Range) Reset() {
+	*x = Range{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_diagnostics_diagnostics_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Range) ProtoMessage() {}
+
+func (x *Range) ProtoReflect() protoreflect.Message {
+	mi := &file_diagnostics_diagnostics_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Range.ProtoReflect.Descriptor instead.
+func (*Range) Descriptor() ([]byte, []int) {
+	return file_diagnostics_diagnostics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Range) GetStartLnum() int64 {
+	if x != nil {
+		return x.StartLnum
+	}
+	return 0
+}
+
+func (x *Range) GetStartCol() int64 {
+	if x != nil {
+		return x.StartCol
+	}
+	return 0
+}
+
+func (x *Range) GetEndLnum() int64 {
+	if x != nil {
+		return x.EndLnum
+	}
+	return 0
+}
+
+func (x *Range) GetEndCol() int64 {
+	if x != nil {
+		return x.EndCol
+	}
+	return 0
+}
+
+// Diagnostic describes a problem found in a buffer.
+type Diagnostic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Severity Severity `protobuf:"varint,1,opt,name=severity,proto3,enum=diagnostics.Severity" json:"severity,omitempty"`
+	Range    *Range   `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+	Message  string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// source specific code of the problem, such as "E501".
+	Code string `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *Diagnostic) Reset() {
+	*x = Diagnostic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_diagnostics_diagnostics_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Diagnostic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Diagnostic) ProtoMessage() {}
+
+func (x *Diagnostic) ProtoReflect() protoreflect.Message {
+	mi := &file_diagnostics_diagnostics_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Diagnostic.ProtoReflect.Descriptor instead.
+func (*Diagnostic) Descriptor() ([]byte, []int) {
+	return file_diagnostics_diagnostics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Diagnostic) GetSeverity() Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Severity_ERROR
+}
+
+func (x *Diagnostic) GetRange() *Range {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *Diagnostic) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Diagnostic) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// DiagnosticSet is the set of diagnostics a source published
+// for a buffer.
+type DiagnosticSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source      string        `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Bufnr       int64         `protobuf:"varint,2,opt,name=bufnr,proto3" json:"bufnr,omitempty"`
+	Diagnostics []*Diagnostic `protobuf:"bytes,3,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+}
+
+func (x *DiagnosticSet) Reset() {
+	*x = DiagnosticSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_diagnostics_diagnostics_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnosticSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticSet) ProtoMessage() {}
+
+func (x *DiagnosticSet) ProtoReflect() protoreflect.Message {
+	mi := &file_diagnostics_diagnostics_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticSet.ProtoReflect.Descriptor instead.
+func (*DiagnosticSet) Descriptor() ([]byte, []int) {
+	return file_diagnostics_diagnostics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DiagnosticSet) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DiagnosticSet) GetBufnr() int64 {
+	if x != nil {
+		return x.Bufnr
+	}
+	return 0
+}
+
+func (x *DiagnosticSet) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+// PublishDiagnosticsRequest defines the PublishDiagnostics rpc arguments.
+//
+// The diagnostics replace the set previously published by the source
+// for the buffer, an empty set clears it.
+type PublishDiagnosticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name of the publisher, such as "golangci-lint" (required)
+	Source      string        `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Bufnr       int64         `protobuf:"varint,2,opt,name=bufnr,proto3" json:"bufnr,omitempty"`
+	Diagnostics []*Diagnostic `protobuf:"bytes,3,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+}
+
+func (x *PublishDiagnosticsRequest) Reset() {
+	*x = PublishDiagnosticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_diagnostics_diagnostics_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishDiagnosticsRequest) ProtoMessage() {}
+
+func (x *PublishDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_diagnostics_diagnostics_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*PublishDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_diagnostics_diagnostics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PublishDiagnosticsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PublishDiagnosticsRequest) GetBufnr() int64 {
+	if x != nil {
+		return x.Bufnr
+	}
+	return 0
+}
+
+func (x *PublishDiagnosticsRequest) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+// PublishDiagnosticsResponse defines the PublishDiagnostics rpc response.
+type PublishDiagnosticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PublishDiagnosticsResponse) Reset() {
+	*x = PublishDiagnosticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_diagnostics_diagnostics_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishDiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishDiagnosticsResponse) ProtoMessage() {}
+
+func (x *PublishDiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_diagnostics_diagnostics_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishDiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*PublishDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_diagnostics_diagnostics_proto_rawDescGZIP(), []int{4}
+}
+
+// ListDiagnosticsRequest defines the ListDiagnostics rpc arguments.
+type ListDiagnosticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// only list the sets of this buffer, zero lists every buffer.
+	Bufnr int64 `protobuf:"varint,1,opt,name=bufnr,proto3" json:"bufnr,omitempty"`
+	// only list the sets of this source, empty lists every source.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *ListDiagnosticsRequest) Reset() {
+	*x = ListDiagnosticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_diagnostics_diagnostics_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiagnosticsRequest) ProtoMessage() {}
+
+func (x *ListDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_diagnostics_diagnostics_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*ListDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_diagnostics_diagnostics_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListDiagnosticsRequest) GetBufnr() int64 {
+	if x != nil {
+		return x.Bufnr
+	}
+	return 0
+}
+
+func (x *ListDiagnosticsRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// ListDiagnosticsResponse defines the ListDiagnostics rpc response.
+type ListDiagnosticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sets []*DiagnosticSet `protobuf:"bytes,1,rep,name=sets,proto3" json:"sets,omitempty"`
+}
+
+func (x *ListDiagnosticsResponse) Reset() {
+	*x = ListDiagnosticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_diagnostics_diagnostics_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiagnosticsResponse) ProtoMessage() {}
+
+func (x *ListDiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_diagnostics_diagnostics_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*ListDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_diagnostics_diagnostics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListDiagnosticsResponse) GetSets() []*DiagnosticSet {
+	if x != nil {
+		return x.Sets
+	}
+	return nil
+}
+
+// PublishResult is Vim's reply to a PublishDiagnostics rpc.
+type PublishResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// TRUE if the buffer in the request exists.
+	Found bool `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+}
+
+func (x *PublishResult) Reset() {
+	*x = PublishResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_diagnostics_diagnostics_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishResult) ProtoMessage() {}
+
+func (x *PublishResult) ProtoReflect() protoreflect.Message {
+	mi := &file_diagnostics_diagnostics_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishResult.ProtoReflect.Descriptor instead.
+func (*PublishResult) Descriptor() ([]byte, []int) {
+	return file_diagnostics_diagnostics_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PublishResult) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+var File_diagnostics_diagnostics_proto protoreflect.FileDescriptor
+
+var file_diagnostics_diagnostics_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2f, 0x64, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x77, 0x0a, 0x05,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c,
+	0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x4c, 0x6e, 0x75, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x6f,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f,
+	0x6c, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x4c, 0x6e, 0x75, 0x6d, 0x12, 0x17, 0x0a, 0x07,
+	0x65, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65,
+	0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x22, 0x97, 0x01, 0x0a, 0x0a, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22,
+	0x78, 0x0a, 0x0d, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x53, 0x65, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x66, 0x6e,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x12, 0x39,
+	0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x19, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x62, 0x75, 0x66, 0x6e, 0x72, 0x12, 0x39, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x22, 0x1c, 0x0a, 0x1a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46,
+	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x66, 0x6e,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x49, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x04, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x44, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x53, 0x65, 0x74, 0x52, 0x04, 0x73, 0x65, 0x74,
+	0x73, 0x22, 0x2b, 0x0a, 0x0d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x2a, 0x36,
+	0x0a, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04,
+	0x48, 0x49, 0x4e, 0x54, 0x10, 0x03, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x64, 0x65, 0x6c, 0x6f, 0x73, 0x73, 0x61, 0x2f, 0x76, 0x69,
+	0x6d, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_diagnostics_diagnostics_proto_rawDescOnce sync.Once
+	file_diagnostics_diagnostics_proto_rawDescData = file_diagnostics_diagnostics_proto_rawDesc
+)
+
+func file_diagnostics_diagnostics_proto_rawDescGZIP() []byte {
+	file_diagnostics_diagnostics_proto_rawDescOnce.Do(func() {
+		file_diagnostics_diagnostics_proto_rawDescData = protoimpl.X.CompressGZIP(file_diagnostics_diagnostics_proto_rawDescData)
+	})
+	return file_diagnostics_diagnostics_proto_rawDescData
+}
+
+var file_diagnostics_diagnostics_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_diagnostics_diagnostics_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_diagnostics_diagnostics_proto_goTypes = []interface{}{
+	(Severity)(0),                      // 0: diagnostics.Severity
+	(*Range)(nil),                      // 1: diagnostics.Range
+	(*Diagnostic)(nil),                 // 2: diagnostics.Diagnostic
+	(*DiagnosticSet)(nil),              // 3: diagnostics.DiagnosticSet
+	(*PublishDiagnosticsRequest)(nil),  // 4: diagnostics.PublishDiagnosticsRequest
+	(*PublishDiagnosticsResponse)(nil), // 5: diagnostics.PublishDiagnosticsResponse
+	(*ListDiagnosticsRequest)(nil),     // 6: diagnostics.ListDiagnosticsRequest
+	(*ListDiagnosticsResponse)(nil),    // 7: diagnostics.ListDiagnosticsResponse
+	(*PublishResult)(nil),              // 8: diagnostics.PublishResult
+}
+var file_diagnostics_diagnostics_proto_depIdxs = []int32{
+	0, // 0: diagnostics.Diagnostic.severity:type_name -> diagnostics.Severity
+	1, // 1: diagnostics.Diagnostic.range:type_name -> diagnostics.Range
+	2, // 2: diagnostics.DiagnosticSet.diagnostics:type_name -> diagnostics.Diagnostic
+	2, // 3: diagnostics.PublishDiagnosticsRequest.diagnostics:type_name -> diagnostics.Diagnostic
+	3, // 4: diagnostics.ListDiagnosticsResponse.sets:type_name -> diagnostics.DiagnosticSet
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_diagnostics_diagnostics_proto_init() }
+func file_diagnostics_diagnostics_proto_init() {
+	if File_diagnostics_diagnostics_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_diagnostics_diagnostics_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Range); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_diagnostics_diagnostics_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Diagnostic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_diagnostics_diagnostics_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnosticSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_diagnostics_diagnostics_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishDiagnosticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_diagnostics_diagnostics_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishDiagnosticsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_diagnostics_diagnostics_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDiagnosticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_diagnostics_diagnostics_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDiagnosticsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_diagnostics_diagnostics_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_diagnostics_diagnostics_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_diagnostics_diagnostics_proto_goTypes,
+		DependencyIndexes: file_diagnostics_diagnostics_proto_depIdxs,
+		EnumInfos:         file_diagnostics_diagnostics_proto_enumTypes,
+		MessageInfos:      file_diagnostics_diagnostics_proto_msgTypes,
+	}.Build()
+	File_diagnostics_diagnostics_proto = out.File
+	file_diagnostics_diagnostics_proto_rawDesc = nil
+	file_diagnostics_diagnostics_proto_goTypes = nil
+	file_diagnostics_diagnostics_proto_depIdxs = nil
+}
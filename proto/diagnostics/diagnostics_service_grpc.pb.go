@@ -0,0 +1,133 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package diagnostics
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// DiagnosticsClient is the client API for Diagnostics service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DiagnosticsClient interface {
+	PublishDiagnostics(ctx context.Context, in *PublishDiagnosticsRequest, opts ...grpc.CallOption) (*PublishDiagnosticsResponse, error)
+	ListDiagnostics(ctx context.Context, in *ListDiagnosticsRequest, opts ...grpc.CallOption) (*ListDiagnosticsResponse, error)
+}
+
+type diagnosticsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDiagnosticsClient(cc grpc.ClientConnInterface) DiagnosticsClient {
+	return &diagnosticsClient{cc}
+}
+
+func (c *diagnosticsClient) PublishDiagnostics(ctx context.Context, in *PublishDiagnosticsRequest, opts ...grpc.CallOption) (*PublishDiagnosticsResponse, error) {
+	out := new(PublishDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, "/diagnostics.Diagnostics/PublishDiagnostics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diagnosticsClient) ListDiagnostics(ctx context.Context, in *ListDiagnosticsRequest, opts ...grpc.CallOption) (*ListDiagnosticsResponse, error) {
+	out := new(ListDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, "/diagnostics.Diagnostics/ListDiagnostics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DiagnosticsServer is the server API for Diagnostics service.
+// All implementations must embed UnimplementedDiagnosticsServer
+// for forward compatibility
+type DiagnosticsServer interface {
+	PublishDiagnostics(context.Context, *PublishDiagnosticsRequest) (*PublishDiagnosticsResponse, error)
+	ListDiagnostics(context.Context, *ListDiagnosticsRequest) (*ListDiagnosticsResponse, error)
+	mustEmbedUnimplementedDiagnosticsServer()
+}
+
+// UnimplementedDiagnosticsServer must be embedded to have forward compatible implementations.
+type UnimplementedDiagnosticsServer struct {
+}
+
+func (UnimplementedDiagnosticsServer) PublishDiagnostics(context.Context, *PublishDiagnosticsRequest) (*PublishDiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishDiagnostics not implemented")
+}
+func (UnimplementedDiagnosticsServer) ListDiagnostics(context.Context, *ListDiagnosticsRequest) (*ListDiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDiagnostics not implemented")
+}
+func (UnimplementedDiagnosticsServer) mustEmbedUnimplementedDiagnosticsServer() {}
+
+// UnsafeDiagnosticsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DiagnosticsServer will
+// result in compilation errors.
+type UnsafeDiagnosticsServer interface {
+	mustEmbedUnimplementedDiagnosticsServer()
+}
+
+func RegisterDiagnosticsServer(s grpc.ServiceRegistrar, srv DiagnosticsServer) {
+	s.RegisterService(&_Diagnostics_serviceDesc, srv)
+}
+
+func _Diagnostics_PublishDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiagnosticsServer).PublishDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/diagnostics.Diagnostics/PublishDiagnostics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiagnosticsServer).PublishDiagnostics(ctx, req.(*PublishDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Diagnostics_ListDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiagnosticsServer).ListDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/diagnostics.Diagnostics/ListDiagnostics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiagnosticsServer).ListDiagnostics(ctx, req.(*ListDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Diagnostics_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "diagnostics.Diagnostics",
+	HandlerType: (*DiagnosticsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PublishDiagnostics",
+			Handler:    _Diagnostics_PublishDiagnostics_Handler,
+		},
+		{
+			MethodName: "ListDiagnostics",
+			Handler:    _Diagnostics_ListDiagnostics_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "diagnostics/diagnostics_service.proto",
+}
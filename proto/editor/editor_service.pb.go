@@ -0,0 +1,88 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: editor/editor_service.proto
+
+package editor
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+var File_editor_editor_service_proto protoreflect.FileDescriptor
+
+var file_editor_editor_service_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x5f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x65,
+	0x64, 0x69, 0x74, 0x6f, 0x72, 0x1a, 0x13, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x65, 0x64,
+	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x7b, 0x0a, 0x06, 0x45, 0x64,
+	0x69, 0x74, 0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x07, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12,
+	0x16, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x33, 0x0a, 0x04, 0x45, 0x76, 0x61, 0x6c, 0x12, 0x13, 0x2e, 0x65, 0x64, 0x69,
+	0x74, 0x6f, 0x72, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x14, 0x2e, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x64, 0x65, 0x6c, 0x6f, 0x73, 0x73, 0x61, 0x2f, 0x76,
+	0x69, 0x6d, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var file_editor_editor_service_proto_goTypes = []interface{}{
+	(*ExecuteRequest)(nil),  // 0: editor.ExecuteRequest
+	(*EvalRequest)(nil),     // 1: editor.EvalRequest
+	(*ExecuteResponse)(nil), // 2: editor.ExecuteResponse
+	(*EvalResponse)(nil),    // 3: editor.EvalResponse
+}
+var file_editor_editor_service_proto_depIdxs = []int32{
+	0, // 0: editor.Editor.Execute:input_type -> editor.ExecuteRequest
+	1, // 1: editor.Editor.Eval:input_type -> editor.EvalRequest
+	2, // 2: editor.Editor.Execute:output_type -> editor.ExecuteResponse
+	3, // 3: editor.Editor.Eval:output_type -> editor.EvalResponse
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_editor_editor_service_proto_init() }
+func file_editor_editor_service_proto_init() {
+	if File_editor_editor_service_proto != nil {
+		return
+	}
+	file_editor_editor_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_editor_editor_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_editor_editor_service_proto_goTypes,
+		DependencyIndexes: file_editor_editor_service_proto_depIdxs,
+	}.Build()
+	File_editor_editor_service_proto = out.File
+	file_editor_editor_service_proto_rawDesc = nil
+	file_editor_editor_service_proto_goTypes = nil
+	file_editor_editor_service_proto_depIdxs = nil
+}
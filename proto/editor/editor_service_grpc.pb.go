@@ -0,0 +1,133 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package editor
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// EditorClient is the client API for Editor service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EditorClient interface {
+	Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*ExecuteResponse, error)
+	Eval(ctx context.Context, in *EvalRequest, opts ...grpc.CallOption) (*EvalResponse, error)
+}
+
+type editorClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEditorClient(cc grpc.ClientConnInterface) EditorClient {
+	return &editorClient{cc}
+}
+
+func (c *editorClient) Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*ExecuteResponse, error) {
+	out := new(ExecuteResponse)
+	err := c.cc.Invoke(ctx, "/editor.Editor/Execute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *editorClient) Eval(ctx context.Context, in *EvalRequest, opts ...grpc.CallOption) (*EvalResponse, error) {
+	out := new(EvalResponse)
+	err := c.cc.Invoke(ctx, "/editor.Editor/Eval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EditorServer is the server API for Editor service.
+// All implementations must embed UnimplementedEditorServer
+// for forward compatibility
+type EditorServer interface {
+	Execute(context.Context, *ExecuteRequest) (*ExecuteResponse, error)
+	Eval(context.Context, *EvalRequest) (*EvalResponse, error)
+	mustEmbedUnimplementedEditorServer()
+}
+
+// UnimplementedEditorServer must be embedded to have forward compatible implementations.
+type UnimplementedEditorServer struct {
+}
+
+func (UnimplementedEditorServer) Execute(context.Context, *ExecuteRequest) (*ExecuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
+}
+func (UnimplementedEditorServer) Eval(context.Context, *EvalRequest) (*EvalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Eval not implemented")
+}
+func (UnimplementedEditorServer) mustEmbedUnimplementedEditorServer() {}
+
+// UnsafeEditorServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EditorServer will
+// result in compilation errors.
+type UnsafeEditorServer interface {
+	mustEmbedUnimplementedEditorServer()
+}
+
+func RegisterEditorServer(s grpc.ServiceRegistrar, srv EditorServer) {
+	s.RegisterService(&_Editor_serviceDesc, srv)
+}
+
+func _Editor_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EditorServer).Execute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/editor.Editor/Execute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EditorServer).Execute(ctx, req.(*ExecuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Editor_Eval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EditorServer).Eval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/editor.Editor/Eval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EditorServer).Eval(ctx, req.(*EvalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Editor_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "editor.Editor",
+	HandlerType: (*EditorServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Execute",
+			Handler:    _Editor_Execute_Handler,
+		},
+		{
+			MethodName: "Eval",
+			Handler:    _Editor_Eval_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "editor/editor_service.proto",
+}
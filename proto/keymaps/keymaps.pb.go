@@ -0,0 +1,789 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: keymaps/keymaps.proto
+
+package keymaps
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// Mode is a mode a mapping applies in.
+type Mode int32
+
+const (
+	Mode_NORMAL Mode = 0
+	// visual mode, not select mode.
+	Mode_VISUAL           Mode = 1
+	Mode_INSERT           Mode = 2
+	Mode_OPERATOR_PENDING Mode = 3
+)
+
+// Enum value maps for Mode.
+var (
+	Mode_name = map[int32]string{
+		0: "NORMAL",
+		1: "VISUAL",
+		2: "INSERT",
+		3: "OPERATOR_PENDING",
+	}
+	Mode_value = map[string]int32{
+		"NORMAL":           0,
+		"VISUAL":           1,
+		"INSERT":           2,
+		"OPERATOR_PENDING": 3,
+	}
+)
+
+func (x Mode) Enum() *Mode {
+	p := new(Mode)
+	*p = x
+	return p
+}
+
+func (x Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_keymaps_keymaps_proto_enumTypes[0].Descriptor()
+}
+
+func (Mode) Type() protoreflect.EnumType {
+	return &file_keymaps_keymaps_proto_enumTypes[0]
+}
+
+func (x Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Mode.Descriptor instead.
+func (Mode) EnumDescriptor() ([]byte, []int) {
+	return file_keymaps_keymaps_proto_rawDescGZIP(), []int{0}
+}
+
+// RegisterKeymapRequest asks vim-grpc.vim to map the described keys
+// on behalf of an extension.
+type RegisterKeymapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Extension string `protobuf:"bytes,1,opt,name=extension,proto3" json:"extension,omitempty"`
+	// keys of the mapping in :map notation, such as "<leader>r".
+	Lhs string `protobuf:"bytes,2,opt,name=lhs,proto3" json:"lhs,omitempty"`
+	// modes the keys are mapped in, at least one.
+	Modes []Mode `protobuf:"varint,3,rep,packed,name=modes,proto3,enum=keymaps.Mode" json:"modes,omitempty"`
+	// the mapping is local to a buffer.
+	Buffer bool `protobuf:"varint,4,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	// buffer the mapping is local to, zero for the current buffer.
+	Bufnr int64 `protobuf:"varint,5,opt,name=bufnr,proto3" json:"bufnr,omitempty"`
+}
+
+func (x *RegisterKeymapRequest) Reset() {
+	*x = RegisterKeymapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keymaps_keymaps_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterKeymapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterKeymapRequest) ProtoMessage() {}
+
+func (x *RegisterKeymapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_keymaps_keymaps_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterKeymapRequest.ProtoReflect.Descriptor instead.
+func (*RegisterKeymapRequest) Descriptor() ([]byte, []int) {
+	return file_keymaps_keymaps_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RegisterKeymapRequest) GetExtension() string {
+	if x != nil {
+		return x.Extension
+	}
+	return ""
+}
+
+func (x *RegisterKeymapRequest) GetLhs() string {
+	if x != nil {
+		return x.Lhs
+	}
+	return ""
+}
+
+func (x *RegisterKeymapRequest) GetModes() []Mode {
+	if x != nil {
+		return x.Modes
+	}
+	return nil
+}
+
+func (x *RegisterKeymapRequest) GetBuffer() bool {
+	if x != nil {
+		return x.Buffer
+	}
+	return false
+}
+
+func (x *RegisterKeymapRequest) GetBufnr() int64 {
+	if x != nil {
+		return x.Bufnr
+	}
+	return 0
+}
+
+// KeymapEvent is a OneOf holding sub-message types affiliated
+// with an extension's registered mapping.
+type KeymapEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*KeymapEvent_Registration
+	//	*KeymapEvent_Triggered
+	Event isKeymapEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *KeymapEvent) Reset() {
+	*x = KeymapEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keymaps_keymaps_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeymapEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeymapEvent) ProtoMessage() {}
+
+func (x *KeymapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_keymaps_keymaps_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeymapEvent.ProtoReflect.Descriptor instead.
+func (*KeymapEvent) Descriptor() ([]byte, []int) {
+	return file_keymaps_keymaps_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *KeymapEvent) GetEvent() isKeymapEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *KeymapEvent) GetRegistration() *KeymapRegistration {
+	if x, ok := x.GetEvent().(*KeymapEvent_Registration); ok {
+		return x.Registration
+	}
+	return nil
+}
+
+func (x *KeymapEvent) GetTriggered() *KeymapTriggered {
+	if x, ok := x.GetEvent().(*KeymapEvent_Triggered); ok {
+		return x.Triggered
+	}
+	return nil
+}
+
+type isKeymapEvent_Event interface {
+	isKeymapEvent_Event()
+}
+
+type KeymapEvent_Registration struct {
+	Registration *KeymapRegistration `protobuf:"bytes,1,opt,name=registration,proto3,oneof"`
+}
+
+type KeymapEvent_Triggered struct {
+	Triggered *KeymapTriggered `protobuf:"bytes,2,opt,name=triggered,proto3,oneof"`
+}
+
+func (*KeymapEvent_Registration) isKeymapEvent_Event() {}
+
+func (*KeymapEvent_Triggered) isKeymapEvent_Event() {}
+
+type KeymapRegistration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Registered bool   `protobuf:"varint,1,opt,name=registered,proto3" json:"registered,omitempty"`
+	Reason     string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// id of the mapping.
+	Id int64 `protobuf:"varint,3,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *KeymapRegistration) Reset() {
+	*x = KeymapRegistration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keymaps_keymaps_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeymapRegistration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeymapRegistration) ProtoMessage() {}
+
+func (x *KeymapRegistration) ProtoReflect() protoreflect.Message {
+	mi := &file_keymaps_keymaps_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeymapRegistration.ProtoReflect.Descriptor instead.
+func (*KeymapRegistration) Descriptor() ([]byte, []int) {
+	return file_keymaps_keymaps_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *KeymapRegistration) GetRegistered() bool {
+	if x != nil {
+		return x.Registered
+	}
+	return false
+}
+
+func (x *KeymapRegistration) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *KeymapRegistration) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// Selection is the visual selection a mapping was used with.
+type Selection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start and end of the selection, in buffer order.
+	StartLnum int64 `protobuf:"varint,1,opt,name=start_lnum,json=startLnum,proto3" json:"start_lnum,omitempty"`
+	StartCol  int64 `protobuf:"varint,2,opt,name=start_col,json=startCol,proto3" json:"start_col,omitempty"`
+	EndLnum   int64 `protobuf:"varint,3,opt,name=end_lnum,json=endLnum,proto3" json:"end_lnum,omitempty"`
+	EndCol    int64 `protobuf:"varint,4,opt,name=end_col,json=endCol,proto3" json:"end_col,omitempty"`
+	// visualmode(): "v", "V" or "\x16" for blockwise.
+	Type string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *Selection) Reset() {
+	*x = Selection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keymaps_keymaps_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Selection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Selection) ProtoMessage() {}
+
+func (x *Selection) ProtoReflect() protoreflect.Message {
+	mi := &file_keymaps_keymaps_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Selection.ProtoReflect.Descriptor instead.
+func (*Selection) Descriptor() ([]byte, []int) {
+	return file_keymaps_keymaps_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Selection) GetStartLnum() int64 {
+	if x != nil {
+		return x.StartLnum
+	}
+	return 0
+}
+
+func (x *Selection) GetStartCol() int64 {
+	if x != nil {
+		return x.StartCol
+	}
+	return 0
+}
+
+func (x *Selection) GetEndLnum() int64 {
+	if x != nil {
+		return x.EndLnum
+	}
+	return 0
+}
+
+func (x *Selection) GetEndCol() int64 {
+	if x != nil {
+		return x.EndCol
+	}
+	return 0
+}
+
+func (x *Selection) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// KeymapTriggered describes a use of a registered mapping.
+type KeymapTriggered struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Lhs  string `protobuf:"bytes,2,opt,name=lhs,proto3" json:"lhs,omitempty"`
+	Mode Mode   `protobuf:"varint,3,opt,name=mode,proto3,enum=keymaps.Mode" json:"mode,omitempty"`
+	// count typed before the keys, v:count.
+	Count int64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// register typed before the keys, v:register.
+	Register string `protobuf:"bytes,5,opt,name=register,proto3" json:"register,omitempty"`
+	// the current buffer and the cursor position.
+	Bufnr int64 `protobuf:"varint,6,opt,name=bufnr,proto3" json:"bufnr,omitempty"`
+	Lnum  int64 `protobuf:"varint,7,opt,name=lnum,proto3" json:"lnum,omitempty"`
+	Col   int64 `protobuf:"varint,8,opt,name=col,proto3" json:"col,omitempty"`
+	// set in VISUAL mode.
+	Selection *Selection `protobuf:"bytes,9,opt,name=selection,proto3" json:"selection,omitempty"`
+	// the pending operator in OPERATOR_PENDING mode, v:operator.
+	// The operator is cancelled.
+	Operator string `protobuf:"bytes,10,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (x *KeymapTriggered) Reset() {
+	*x = KeymapTriggered{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keymaps_keymaps_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeymapTriggered) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeymapTriggered) ProtoMessage() {}
+
+func (x *KeymapTriggered) ProtoReflect() protoreflect.Message {
+	mi := &file_keymaps_keymaps_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeymapTriggered.ProtoReflect.Descriptor instead.
+func (*KeymapTriggered) Descriptor() ([]byte, []int) {
+	return file_keymaps_keymaps_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *KeymapTriggered) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *KeymapTriggered) GetLhs() string {
+	if x != nil {
+		return x.Lhs
+	}
+	return ""
+}
+
+func (x *KeymapTriggered) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_NORMAL
+}
+
+func (x *KeymapTriggered) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *KeymapTriggered) GetRegister() string {
+	if x != nil {
+		return x.Register
+	}
+	return ""
+}
+
+func (x *KeymapTriggered) GetBufnr() int64 {
+	if x != nil {
+		return x.Bufnr
+	}
+	return 0
+}
+
+func (x *KeymapTriggered) GetLnum() int64 {
+	if x != nil {
+		return x.Lnum
+	}
+	return 0
+}
+
+func (x *KeymapTriggered) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *KeymapTriggered) GetSelection() *Selection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+func (x *KeymapTriggered) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+// KeymapDefinition is the body of the RegisterKeymap and UnregisterKeymap
+// rpcs sent to Vim.
+type KeymapDefinition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Keymap *RegisterKeymapRequest `protobuf:"bytes,2,opt,name=keymap,proto3" json:"keymap,omitempty"`
+}
+
+func (x *KeymapDefinition) Reset() {
+	*x = KeymapDefinition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_keymaps_keymaps_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeymapDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeymapDefinition) ProtoMessage() {}
+
+func (x *KeymapDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_keymaps_keymaps_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeymapDefinition.ProtoReflect.Descriptor instead.
+func (*KeymapDefinition) Descriptor() ([]byte, []int) {
+	return file_keymaps_keymaps_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *KeymapDefinition) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *KeymapDefinition) GetKeymap() *RegisterKeymapRequest {
+	if x != nil {
+		return x.Keymap
+	}
+	return nil
+}
+
+var File_keymaps_keymaps_proto protoreflect.FileDescriptor
+
+var file_keymaps_keymaps_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x6b, 0x65, 0x79, 0x6d, 0x61, 0x70, 0x73, 0x2f, 0x6b, 0x65, 0x79, 0x6d, 0x61, 0x70,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6b, 0x65, 0x79, 0x6d, 0x61, 0x70, 0x73,
+	0x22, 0x9a, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x6d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x68, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x68, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x6d, 0x6f,
+	0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6b, 0x65, 0x79, 0x6d,
+	0x61, 0x70, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x22, 0x93, 0x01,
+	0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x6d, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x41, 0x0a,
+	0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6b, 0x65, 0x79, 0x6d, 0x61, 0x70, 0x73, 0x2e, 0x4b, 0x65,
+	0x79, 0x6d, 0x61, 0x70, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x38, 0x0a, 0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x65, 0x79, 0x6d, 0x61, 0x70, 0x73, 0x2e, 0x4b, 0x65,
+	0x79, 0x6d, 0x61, 0x70, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52,
+	0x09, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x22, 0x5c, 0x0a, 0x12, 0x4b, 0x65, 0x79, 0x6d, 0x61, 0x70, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x8f, 0x01, 0x0a, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x6e, 0x75, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x6e, 0x75, 0x6d, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x6c, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x4c, 0x6e, 0x75, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x6f,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x22, 0x92, 0x02, 0x0a, 0x0f, 0x4b, 0x65, 0x79, 0x6d, 0x61, 0x70, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x68, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x68, 0x73, 0x12, 0x21, 0x0a, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x6b, 0x65, 0x79, 0x6d, 0x61, 0x70,
+	0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62,
+	0x75, 0x66, 0x6e, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6e, 0x75, 0x6d, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x6c, 0x6e, 0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x6b, 0x65, 0x79, 0x6d, 0x61, 0x70, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x5a, 0x0a, 0x10, 0x4b, 0x65, 0x79, 0x6d,
+	0x61, 0x70, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x06,
+	0x6b, 0x65, 0x79, 0x6d, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6b,
+	0x65, 0x79, 0x6d, 0x61, 0x70, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x4b,
+	0x65, 0x79, 0x6d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x06, 0x6b, 0x65,
+	0x79, 0x6d, 0x61, 0x70, 0x2a, 0x40, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06,
+	0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x49, 0x53, 0x55,
+	0x41, 0x4c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x02,
+	0x12, 0x14, 0x0a, 0x10, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x50, 0x45, 0x4e,
+	0x44, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x64, 0x65, 0x6c, 0x6f, 0x73, 0x73, 0x61, 0x2f, 0x76, 0x69,
+	0x6d, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x6b, 0x65, 0x79, 0x6d, 0x61, 0x70, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_keymaps_keymaps_proto_rawDescOnce sync.Once
+	file_keymaps_keymaps_proto_rawDescData = file_keymaps_keymaps_proto_rawDesc
+)
+
+func file_keymaps_keymaps_proto_rawDescGZIP() []byte {
+	file_keymaps_keymaps_proto_rawDescOnce.Do(func() {
+		file_keymaps_keymaps_proto_rawDescData = protoimpl.X.CompressGZIP(file_keymaps_keymaps_proto_rawDescData)
+	})
+	return file_keymaps_keymaps_proto_rawDescData
+}
+
+var file_keymaps_keymaps_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_keymaps_keymaps_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_keymaps_keymaps_proto_goTypes = []interface{}{
+	(Mode)(0),                     // 0: keymaps.Mode
+	(*RegisterKeymapRequest)(nil), // 1: keymaps.RegisterKeymapRequest
+	(*KeymapEvent)(nil),           // 2: keymaps.KeymapEvent
+	(*KeymapRegistration)(nil),    // 3: keymaps.KeymapRegistration
+	(*Selection)(nil),             // 4: keymaps.Selection
+	(*KeymapTriggered)(nil),       // 5: keymaps.KeymapTriggered
+	(*KeymapDefinition)(nil),      // 6: keymaps.KeymapDefinition
+}
+var file_keymaps_keymaps_proto_depIdxs = []int32{
+	0, // 0: keymaps.RegisterKeymapRequest.modes:type_name -> keymaps.Mode
+	3, // 1: keymaps.KeymapEvent.registration:type_name -> keymaps.KeymapRegistration
+	5, // 2: keymaps.KeymapEvent.triggered:type_name -> keymaps.KeymapTriggered
+	0, // 3: keymaps.KeymapTriggered.mode:type_name -> keymaps.Mode
+	4, // 4: keymaps.KeymapTriggered.selection:type_name -> keymaps.Selection
+	1, // 5: keymaps.KeymapDefinition.keymap:type_name -> keymaps.RegisterKeymapRequest
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_keymaps_keymaps_proto_init() }
+func file_keymaps_keymaps_proto_init() {
+	if File_keymaps_keymaps_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_keymaps_keymaps_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterKeymapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keymaps_keymaps_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeymapEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keymaps_keymaps_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeymapRegistration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keymaps_keymaps_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Selection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keymaps_keymaps_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeymapTriggered); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_keymaps_keymaps_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeymapDefinition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_keymaps_keymaps_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*KeymapEvent_Registration)(nil),
+		(*KeymapEvent_Triggered)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_keymaps_keymaps_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_keymaps_keymaps_proto_goTypes,
+		DependencyIndexes: file_keymaps_keymaps_proto_depIdxs,
+		EnumInfos:         file_keymaps_keymaps_proto_enumTypes,
+		MessageInfos:      file_keymaps_keymaps_proto_msgTypes,
+	}.Build()
+	File_keymaps_keymaps_proto = out.File
+	file_keymaps_keymaps_proto_rawDesc = nil
+	file_keymaps_keymaps_proto_goTypes = nil
+	file_keymaps_keymaps_proto_depIdxs = nil
+}
@@ -0,0 +1,124 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package keymaps
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// KeymapsClient is the client API for Keymaps service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type KeymapsClient interface {
+	RegisterKeymap(ctx context.Context, in *RegisterKeymapRequest, opts ...grpc.CallOption) (Keymaps_RegisterKeymapClient, error)
+}
+
+type keymapsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKeymapsClient(cc grpc.ClientConnInterface) KeymapsClient {
+	return &keymapsClient{cc}
+}
+
+func (c *keymapsClient) RegisterKeymap(ctx context.Context, in *RegisterKeymapRequest, opts ...grpc.CallOption) (Keymaps_RegisterKeymapClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Keymaps_serviceDesc.Streams[0], "/keymaps.Keymaps/RegisterKeymap", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &keymapsRegisterKeymapClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Keymaps_RegisterKeymapClient interface {
+	Recv() (*KeymapEvent, error)
+	grpc.ClientStream
+}
+
+type keymapsRegisterKeymapClient struct {
+	grpc.ClientStream
+}
+
+func (x *keymapsRegisterKeymapClient) Recv() (*KeymapEvent, error) {
+	m := new(KeymapEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// KeymapsServer is the server API for Keymaps service.
+// All implementations must embed UnimplementedKeymapsServer
+// for forward compatibility
+type KeymapsServer interface {
+	RegisterKeymap(*RegisterKeymapRequest, Keymaps_RegisterKeymapServer) error
+	mustEmbedUnimplementedKeymapsServer()
+}
+
+// UnimplementedKeymapsServer must be embedded to have forward compatible implementations.
+type UnimplementedKeymapsServer struct {
+}
+
+func (UnimplementedKeymapsServer) RegisterKeymap(*RegisterKeymapRequest, Keymaps_RegisterKeymapServer) error {
+	return status.Errorf(codes.Unimplemented, "method RegisterKeymap not implemented")
+}
+func (UnimplementedKeymapsServer) mustEmbedUnimplementedKeymapsServer() {}
+
+// UnsafeKeymapsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KeymapsServer will
+// result in compilation errors.
+type UnsafeKeymapsServer interface {
+	mustEmbedUnimplementedKeymapsServer()
+}
+
+func RegisterKeymapsServer(s grpc.ServiceRegistrar, srv KeymapsServer) {
+	s.RegisterService(&_Keymaps_serviceDesc, srv)
+}
+
+func _Keymaps_RegisterKeymap_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RegisterKeymapRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KeymapsServer).RegisterKeymap(m, &keymapsRegisterKeymapServer{stream})
+}
+
+type Keymaps_RegisterKeymapServer interface {
+	Send(*KeymapEvent) error
+	grpc.ServerStream
+}
+
+type keymapsRegisterKeymapServer struct {
+	grpc.ServerStream
+}
+
+func (x *keymapsRegisterKeymapServer) Send(m *KeymapEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Keymaps_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "keymaps.Keymaps",
+	HandlerType: (*KeymapsServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RegisterKeymap",
+			Handler:       _Keymaps_RegisterKeymap_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "keymaps/keymaps_service.proto",
+}
@@ -0,0 +1,969 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: quickfix/quickfix.proto
+
+package quickfix
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type SetListRequest_Action int32
+
+const (
+	// replace the list with a new one.
+	SetListRequest_REPLACE SetListRequest_Action = 0
+	// append items to the current list.
+	SetListRequest_APPEND SetListRequest_Action = 1
+)
+
+// Enum value maps for SetListRequest_Action.
+var (
+	SetListRequest_Action_name = map[int32]string{
+		0: "REPLACE",
+		1: "APPEND",
+	}
+	SetListRequest_Action_value = map[string]int32{
+		"REPLACE": 0,
+		"APPEND":  1,
+	}
+)
+
+func (x SetListRequest_Action) Enum() *SetListRequest_Action {
+	p := new(SetListRequest_Action)
+	*p = x
+	return p
+}
+
+func (x SetListRequest_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SetListRequest_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_quickfix_quickfix_proto_enumTypes[0].Descriptor()
+}
+
+func (SetListRequest_Action) Type() protoreflect.EnumType {
+	return &file_quickfix_quickfix_proto_enumTypes[0]
+}
+
+func (x SetListRequest_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SetListRequest_Action.Descriptor instead.
+func (SetListRequest_Action) EnumDescriptor() ([]byte, []int) {
+	return file_quickfix_quickfix_proto_rawDescGZIP(), []int{1, 0}
+}
+
+// Item is an entry of a quickfix or location list.
+type Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// file the item refers to, ignored when bufnr is set.
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Bufnr    int64  `protobuf:"varint,2,opt,name=bufnr,proto3" json:"bufnr,omitempty"`
+	Lnum     int64  `protobuf:"varint,3,opt,name=lnum,proto3" json:"lnum,omitempty"`
+	Col      int64  `protobuf:"varint,4,opt,name=col,proto3" json:"col,omitempty"`
+	EndLnum  int64  `protobuf:"varint,5,opt,name=end_lnum,json=endLnum,proto3" json:"end_lnum,omitempty"`
+	EndCol   int64  `protobuf:"varint,6,opt,name=end_col,json=endCol,proto3" json:"end_col,omitempty"`
+	// single character type such as "E", "W", "I" or "N".
+	Type string `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	Text string `protobuf:"bytes,8,opt,name=text,proto3" json:"text,omitempty"`
+	// TRUE if Vim recognized the item's location.
+	Valid bool `protobuf:"varint,9,opt,name=valid,proto3" json:"valid,omitempty"`
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_quickfix_quickfix_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_quickfix_quickfix_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_quickfix_quickfix_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Item) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Item) GetBufnr() int64 {
+	if x != nil {
+		return x.Bufnr
+	}
+	return 0
+}
+
+func (x *Item) GetLnum() int64 {
+	if x != nil {
+		return x.Lnum
+	}
+	return 0
+}
+
+func (x *Item) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *Item) GetEndLnum() int64 {
+	if x != nil {
+		return x.EndLnum
+	}
+	return 0
+}
+
+func (x *Item) GetEndCol() int64 {
+	if x != nil {
+		return x.EndCol
+	}
+	return 0
+}
+
+func (x *Item) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Item) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Item) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+// SetListRequest defines the SetList rpc arguments.
+type SetListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// window whose location list is set, zero for the quickfix list.
+	Winid  int64                 `protobuf:"varint,1,opt,name=winid,proto3" json:"winid,omitempty"`
+	Action SetListRequest_Action `protobuf:"varint,2,opt,name=action,proto3,enum=quickfix.SetListRequest_Action" json:"action,omitempty"`
+	Title  string                `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// arbitrary value stored with the list.
+	Context *structpb.Value `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty"`
+	Items   []*Item         `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *SetListRequest) Reset() {
+	*x = SetListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_quickfix_quickfix_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetListRequest) ProtoMessage() {}
+
+func (x *SetListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quickfix_quickfix_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetListRequest.ProtoReflect.Descriptor instead.
+func (*SetListRequest) Descriptor() ([]byte, []int) {
+	return file_quickfix_quickfix_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetListRequest) GetWinid() int64 {
+	if x != nil {
+		return x.Winid
+	}
+	return 0
+}
+
+func (x *SetListRequest) GetAction() SetListRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return SetListRequest_REPLACE
+}
+
+func (x *SetListRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SetListRequest) GetContext() *structpb.Value {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *SetListRequest) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// SetListResponse defines the SetList rpc response.
+type SetListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the list set.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *SetListResponse) Reset() {
+	*x = SetListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_quickfix_quickfix_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetListResponse) ProtoMessage() {}
+
+func (x *SetListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quickfix_quickfix_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetListResponse.ProtoReflect.Descriptor instead.
+func (*SetListResponse) Descriptor() ([]byte, []int) {
+	return file_quickfix_quickfix_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetListResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// GetListRequest defines the GetList rpc arguments.
+type GetListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// window whose location list is returned, zero for the quickfix list.
+	Winid int64 `protobuf:"varint,1,opt,name=winid,proto3" json:"winid,omitempty"`
+}
+
+func (x *GetListRequest) Reset() {
+	*x = GetListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_quickfix_quickfix_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListRequest) ProtoMessage() {}
+
+func (x *GetListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quickfix_quickfix_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListRequest.ProtoReflect.Descriptor instead.
+func (*GetListRequest) Descriptor() ([]byte, []int) {
+	return file_quickfix_quickfix_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetListRequest) GetWinid() int64 {
+	if x != nil {
+		return x.Winid
+	}
+	return 0
+}
+
+// GetListResponse defines the GetList rpc response.
+type GetListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title   string          `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Context *structpb.Value `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	Items   []*Item         `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *GetListResponse) Reset() {
+	*x = GetListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_quickfix_quickfix_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListResponse) ProtoMessage() {}
+
+func (x *GetListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quickfix_quickfix_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListResponse.ProtoReflect.Descriptor instead.
+func (*GetListResponse) Descriptor() ([]byte, []int) {
+	return file_quickfix_quickfix_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetListResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetListResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetListResponse) GetContext() *structpb.Value {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *GetListResponse) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// OpenListRequest defines the OpenList rpc arguments.
+type OpenListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// window whose location list window is opened, zero for the
+	// quickfix window.
+	Winid int64 `protobuf:"varint,1,opt,name=winid,proto3" json:"winid,omitempty"`
+	// height of the list window, zero for Vim's default.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *OpenListRequest) Reset() {
+	*x = OpenListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_quickfix_quickfix_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenListRequest) ProtoMessage() {}
+
+func (x *OpenListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quickfix_quickfix_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenListRequest.ProtoReflect.Descriptor instead.
+func (*OpenListRequest) Descriptor() ([]byte, []int) {
+	return file_quickfix_quickfix_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OpenListRequest) GetWinid() int64 {
+	if x != nil {
+		return x.Winid
+	}
+	return 0
+}
+
+func (x *OpenListRequest) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// OpenListResponse defines the OpenList rpc response.
+type OpenListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the list window.
+	Winid int64 `protobuf:"varint,1,opt,name=winid,proto3" json:"winid,omitempty"`
+}
+
+func (x *OpenListResponse) Reset() {
+	*x = OpenListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_quickfix_quickfix_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenListResponse) ProtoMessage() {}
+
+func (x *OpenListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quickfix_quickfix_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenListResponse.ProtoReflect.Descriptor instead.
+func (*OpenListResponse) Descriptor() ([]byte, []int) {
+	return file_quickfix_quickfix_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OpenListResponse) GetWinid() int64 {
+	if x != nil {
+		return x.Winid
+	}
+	return 0
+}
+
+// CloseListRequest defines the CloseList rpc arguments.
+type CloseListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// window whose location list window is closed, zero for the
+	// quickfix window.
+	Winid int64 `protobuf:"varint,1,opt,name=winid,proto3" json:"winid,omitempty"`
+}
+
+func (x *CloseListRequest) Reset() {
+	*x = CloseListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_quickfix_quickfix_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseListRequest) ProtoMessage() {}
+
+func (x *CloseListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quickfix_quickfix_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseListRequest.ProtoReflect.Descriptor instead.
+func (*CloseListRequest) Descriptor() ([]byte, []int) {
+	return file_quickfix_quickfix_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CloseListRequest) GetWinid() int64 {
+	if x != nil {
+		return x.Winid
+	}
+	return 0
+}
+
+// CloseListResponse defines the CloseList rpc response.
+type CloseListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CloseListResponse) Reset() {
+	*x = CloseListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_quickfix_quickfix_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseListResponse) ProtoMessage() {}
+
+func (x *CloseListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_quickfix_quickfix_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseListResponse.ProtoReflect.Descriptor instead.
+func (*CloseListResponse) Descriptor() ([]byte, []int) {
+	return file_quickfix_quickfix_proto_rawDescGZIP(), []int{8}
+}
+
+// ListResult is Vim's reply to the list rpcs.
+type ListResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// TRUE if the window in the request exists.
+	Found bool `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	// id of the list set or of the list window opened.
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// the list read by a GetList rpc.
+	List *GetListResponse `protobuf:"bytes,3,opt,name=list,proto3" json:"list,omitempty"`
+}
+
+func (x *ListResult) Reset() {
+	*x = ListResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_quickfix_quickfix_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResult) ProtoMessage() {}
+
+func (x *ListResult) ProtoReflect() protoreflect.Message {
+	mi := &file_quickfix_quickfix_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResult.ProtoReflect.Descriptor instead.
+func (*ListResult) Descriptor() ([]byte, []int) {
+	return file_quickfix_quickfix_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListResult) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *ListResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListResult) GetList() *GetListResponse {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+var File_quickfix_quickfix_proto protoreflect.FileDescriptor
+
+var file_quickfix_quickfix_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x69, 0x78, 0x2f, 0x71, 0x75, 0x69, 0x63, 0x6b,
+	0x66, 0x69, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x71, 0x75, 0x69, 0x63, 0x6b,
+	0x66, 0x69, 0x78, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xd0, 0x01, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x6c, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x6e, 0x75, 0x6d,
+	0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63,
+	0x6f, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x6e, 0x75, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x4c, 0x6e, 0x75, 0x6d, 0x12, 0x17, 0x0a,
+	0x07, 0x65, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x22, 0xf0, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64, 0x12, 0x37, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x69, 0x78, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x24,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x69, 0x78, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0x21, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b,
+	0x0a, 0x07, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41,
+	0x50, 0x50, 0x45, 0x4e, 0x44, 0x10, 0x01, 0x22, 0x21, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x77, 0x69, 0x6e, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x6e,
+	0x69, 0x64, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x24,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x69, 0x78, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0x3f, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x28, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x6e,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64, 0x22,
+	0x28, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x67,
+	0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x69, 0x78, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x64, 0x65, 0x6c, 0x6f, 0x73, 0x73, 0x61, 0x2f, 0x76,
+	0x69, 0x6d, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x69, 0x78, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_quickfix_quickfix_proto_rawDescOnce sync.Once
+	file_quickfix_quickfix_proto_rawDescData = file_quickfix_quickfix_proto_rawDesc
+)
+
+func file_quickfix_quickfix_proto_rawDescGZIP() []byte {
+	file_quickfix_quickfix_proto_rawDescOnce.Do(func() {
+		file_quickfix_quickfix_proto_rawDescData = protoimpl.X.CompressGZIP(file_quickfix_quickfix_proto_rawDescData)
+	})
+	return file_quickfix_quickfix_proto_rawDescData
+}
+
+var file_quickfix_quickfix_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_quickfix_quickfix_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_quickfix_quickfix_proto_goTypes = []interface{}{
+	(SetListRequest_Action)(0), // 0: quickfix.SetListRequest.Action
+	(*Item)(nil),               // 1: quickfix.Item
+	(*SetListRequest)(nil),     // 2: quickfix.SetListRequest
+	(*SetListResponse)(nil),    // 3: quickfix.SetListResponse
+	(*GetListRequest)(nil),     // 4: quickfix.GetListRequest
+	(*GetListResponse)(nil),    // 5: quickfix.GetListResponse
+	(*OpenListRequest)(nil),    // 6: quickfix.OpenListRequest
+	(*OpenListResponse)(nil),   // 7: quickfix.OpenListResponse
+	(*CloseListRequest)(nil),   // 8: quickfix.CloseListRequest
+	(*CloseListResponse)(nil),  // 9: quickfix.CloseListResponse
+	(*ListResult)(nil),         // 10: quickfix.ListResult
+	(*structpb.Value)(nil),     // 11: google.protobuf.Value
+}
+var file_quickfix_quickfix_proto_depIdxs = []int32{
+	0,  // 0: quickfix.SetListRequest.action:type_name -> quickfix.SetListRequest.Action
+	11, // 1: quickfix.SetListRequest.context:type_name -> google.protobuf.Value
+	1,  // 2: quickfix.SetListRequest.items:type_name -> quickfix.Item
+	11, // 3: quickfix.GetListResponse.context:type_name -> google.protobuf.Value
+	1,  // 4: quickfix.GetListResponse.items:type_name -> quickfix.Item
+	5,  // 5: quickfix.ListResult.list:type_name -> quickfix.GetListResponse
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_quickfix_quickfix_proto_init() }
+func file_quickfix_quickfix_proto_init() {
+	if File_quickfix_quickfix_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_quickfix_quickfix_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_quickfix_quickfix_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_quickfix_quickfix_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_quickfix_quickfix_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_quickfix_quickfix_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_quickfix_quickfix_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_quickfix_quickfix_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_quickfix_quickfix_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_quickfix_quickfix_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_quickfix_quickfix_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_quickfix_quickfix_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_quickfix_quickfix_proto_goTypes,
+		DependencyIndexes: file_quickfix_quickfix_proto_depIdxs,
+		EnumInfos:         file_quickfix_quickfix_proto_enumTypes,
+		MessageInfos:      file_quickfix_quickfix_proto_msgTypes,
+	}.Build()
+	File_quickfix_quickfix_proto = out.File
+	file_quickfix_quickfix_proto_rawDesc = nil
+	file_quickfix_quickfix_proto_goTypes = nil
+	file_quickfix_quickfix_proto_depIdxs = nil
+}
@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: quickfix/quickfix_service.proto
+
+package quickfix
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+var File_quickfix_quickfix_service_proto protoreflect.FileDescriptor
+
+var file_quickfix_quickfix_service_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x69, 0x78, 0x2f, 0x71, 0x75, 0x69, 0x63, 0x6b,
+	0x66, 0x69, 0x78, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x08, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x69, 0x78, 0x1a, 0x17, 0x71, 0x75, 0x69,
+	0x63, 0x6b, 0x66, 0x69, 0x78, 0x2f, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x69, 0x78, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x32, 0x9b, 0x02, 0x0a, 0x08, 0x51, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x69,
+	0x78, 0x12, 0x40, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x71,
+	0x75, 0x69, 0x63, 0x6b, 0x66, 0x69, 0x78, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x69,
+	0x78, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18,
+	0x2e, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x69, 0x78, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x71, 0x75, 0x69, 0x63, 0x6b,
+	0x66, 0x69, 0x78, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x19, 0x2e, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x69, 0x78, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x71,
+	0x75, 0x69, 0x63, 0x6b, 0x66, 0x69, 0x78, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66,
+	0x69, 0x78, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x66, 0x69, 0x78, 0x2e, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6c, 0x64, 0x65, 0x6c, 0x6f, 0x73, 0x73, 0x61, 0x2f, 0x76, 0x69, 0x6d, 0x2d, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x69, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x71, 0x75, 0x69,
+	0x63, 0x6b, 0x66, 0x69, 0x78, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var file_quickfix_quickfix_service_proto_goTypes = []interface{}{
+	(*SetListRequest)(nil),    // 0: quickfix.SetListRequest
+	(*GetListRequest)(nil),    // 1: quickfix.GetListRequest
+	(*OpenListRequest)(nil),   // 2: quickfix.OpenListRequest
+	(*CloseListRequest)(nil),  // 3: quickfix.CloseListRequest
+	(*SetListResponse)(nil),   // 4: quickfix.SetListResponse
+	(*GetListResponse)(nil),   // 5: quickfix.GetListResponse
+	(*OpenListResponse)(nil),  // 6: quickfix.OpenListResponse
+	(*CloseListResponse)(nil), // 7: quickfix.CloseListResponse
+}
+var file_quickfix_quickfix_service_proto_depIdxs = []int32{
+	0, // 0: quickfix.Quickfix.SetList:input_type -> quickfix.SetListRequest
+	1, // 1: quickfix.Quickfix.GetList:input_type -> quickfix.GetListRequest
+	2, // 2: quickfix.Quickfix.OpenList:input_type -> quickfix.OpenListRequest
+	3, // 3: quickfix.Quickfix.CloseList:input_type -> quickfix.CloseListRequest
+	4, // 4: quickfix.Quickfix.SetList:output_type -> quickfix.SetListResponse
+	5, // 5: quickfix.Quickfix.GetList:output_type -> quickfix.GetListResponse
+	6, // 6: quickfix.Quickfix.OpenList:output_type -> quickfix.OpenListResponse
+	7, // 7: quickfix.Quickfix.CloseList:output_type -> quickfix.CloseListResponse
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_quickfix_quickfix_service_proto_init() }
+func file_quickfix_quickfix_service_proto_init() {
+	if File_quickfix_quickfix_service_proto != nil {
+		return
+	}
+	file_quickfix_quickfix_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_quickfix_quickfix_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_quickfix_quickfix_service_proto_goTypes,
+		DependencyIndexes: file_quickfix_quickfix_service_proto_depIdxs,
+	}.Build()
+	File_quickfix_quickfix_service_proto = out.File
+	file_quickfix_quickfix_service_proto_rawDesc = nil
+	file_quickfix_quickfix_service_proto_goTypes = nil
+	file_quickfix_quickfix_service_proto_depIdxs = nil
+}
@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package quickfix
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// QuickfixClient is the client API for Quickfix service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuickfixClient interface {
+	SetList(ctx context.Context, in *SetListRequest, opts ...grpc.CallOption) (*SetListResponse, error)
+	GetList(ctx context.Context, in *GetListRequest, opts ...grpc.CallOption) (*GetListResponse, error)
+	OpenList(ctx context.Context, in *OpenListRequest, opts ...grpc.CallOption) (*OpenListResponse, error)
+	CloseList(ctx context.Context, in *CloseListRequest, opts ...grpc.CallOption) (*CloseListResponse, error)
+}
+
+type quickfixClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuickfixClient(cc grpc.ClientConnInterface) QuickfixClient {
+	return &quickfixClient{cc}
+}
+
+func (c *quickfixClient) SetList(ctx context.Context, in *SetListRequest, opts ...grpc.CallOption) (*SetListResponse, error) {
+	out := new(SetListResponse)
+	err := c.cc.Invoke(ctx, "/quickfix.Quickfix/SetList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quickfixClient) GetList(ctx context.Context, in *GetListRequest, opts ...grpc.CallOption) (*GetListResponse, error) {
+	out := new(GetListResponse)
+	err := c.cc.Invoke(ctx, "/quickfix.Quickfix/GetList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quickfixClient) OpenList(ctx context.Context, in *OpenListRequest, opts ...grpc.CallOption) (*OpenListResponse, error) {
+	out := new(OpenListResponse)
+	err := c.cc.Invoke(ctx, "/quickfix.Quickfix/OpenList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quickfixClient) CloseList(ctx context.Context, in *CloseListRequest, opts ...grpc.CallOption) (*CloseListResponse, error) {
+	out := new(CloseListResponse)
+	err := c.cc.Invoke(ctx, "/quickfix.Quickfix/CloseList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuickfixServer is the server API for Quickfix service.
+// All implementations must embed UnimplementedQuickfixServer
+// for forward compatibility
+type QuickfixServer interface {
+	SetList(context.Context, *SetListRequest) (*SetListResponse, error)
+	GetList(context.Context, *GetListRequest) (*GetListResponse, error)
+	OpenList(context.Context, *OpenListRequest) (*OpenListResponse, error)
+	CloseList(context.Context, *CloseListRequest) (*CloseListResponse, error)
+	mustEmbedUnimplementedQuickfixServer()
+}
+
+// UnimplementedQuickfixServer must be embedded to have forward compatible implementations.
+type UnimplementedQuickfixServer struct {
+}
+
+func (UnimplementedQuickfixServer) SetList(context.Context, *SetListRequest) (*SetListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetList not implemented")
+}
+func (UnimplementedQuickfixServer) GetList(context.Context, *GetListRequest) (*GetListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedQuickfixServer) OpenList(context.Context, *OpenListRequest) (*OpenListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenList not implemented")
+}
+func (UnimplementedQuickfixServer) CloseList(context.Context, *CloseListRequest) (*CloseListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseList not implemented")
+}
+func (UnimplementedQuickfixServer) mustEmbedUnimplementedQuickfixServer() {}
+
+// UnsafeQuickfixServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuickfixServer will
+// result in compilation errors.
+type UnsafeQuickfixServer interface {
+	mustEmbedUnimplementedQuickfixServer()
+}
+
+func RegisterQuickfixServer(s grpc.ServiceRegistrar, srv QuickfixServer) {
+	s.RegisterService(&_Quickfix_serviceDesc, srv)
+}
+
+func _Quickfix_SetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuickfixServer).SetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quickfix.Quickfix/SetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuickfixServer).SetList(ctx, req.(*SetListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Quickfix_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuickfixServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quickfix.Quickfix/GetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuickfixServer).GetList(ctx, req.(*GetListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Quickfix_OpenList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuickfixServer).OpenList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quickfix.Quickfix/OpenList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuickfixServer).OpenList(ctx, req.(*OpenListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Quickfix_CloseList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuickfixServer).CloseList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/quickfix.Quickfix/CloseList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuickfixServer).CloseList(ctx, req.(*CloseListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Quickfix_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "quickfix.Quickfix",
+	HandlerType: (*QuickfixServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetList",
+			Handler:    _Quickfix_SetList_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _Quickfix_GetList_Handler,
+		},
+		{
+			MethodName: "OpenList",
+			Handler:    _Quickfix_OpenList_Handler,
+		},
+		{
+			MethodName: "CloseList",
+			Handler:    _Quickfix_CloseList_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "quickfix/quickfix_service.proto",
+}
@@ -18,6 +18,11 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ProxyClient interface {
 	GetBufInfo(ctx context.Context, in *GetBufInfoRequest, opts ...grpc.CallOption) (*GetBufInfoResponse, error)
+	GetLines(ctx context.Context, in *GetLinesRequest, opts ...grpc.CallOption) (*GetLinesResponse, error)
+	SetLines(ctx context.Context, in *SetLinesRequest, opts ...grpc.CallOption) (*SetLinesResponse, error)
+	InsertLines(ctx context.Context, in *InsertLinesRequest, opts ...grpc.CallOption) (*InsertLinesResponse, error)
+	DeleteLines(ctx context.Context, in *DeleteLinesRequest, opts ...grpc.CallOption) (*DeleteLinesResponse, error)
+	WatchBuffer(ctx context.Context, in *WatchBufferRequest, opts ...grpc.CallOption) (Proxy_WatchBufferClient, error)
 }
 
 type proxyClient struct {
@@ -37,11 +42,84 @@ func (c *proxyClient) GetBufInfo(ctx context.Context, in *GetBufInfoRequest, opt
 	return out, nil
 }
 
+func (c *proxyClient) GetLines(ctx context.Context, in *GetLinesRequest, opts ...grpc.CallOption) (*GetLinesResponse, error) {
+	out := new(GetLinesResponse)
+	err := c.cc.Invoke(ctx, "/proto.Proxy/GetLines", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) SetLines(ctx context.Context, in *SetLinesRequest, opts ...grpc.CallOption) (*SetLinesResponse, error) {
+	out := new(SetLinesResponse)
+	err := c.cc.Invoke(ctx, "/proto.Proxy/SetLines", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) InsertLines(ctx context.Context, in *InsertLinesRequest, opts ...grpc.CallOption) (*InsertLinesResponse, error) {
+	out := new(InsertLinesResponse)
+	err := c.cc.Invoke(ctx, "/proto.Proxy/InsertLines", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) DeleteLines(ctx context.Context, in *DeleteLinesRequest, opts ...grpc.CallOption) (*DeleteLinesResponse, error) {
+	out := new(DeleteLinesResponse)
+	err := c.cc.Invoke(ctx, "/proto.Proxy/DeleteLines", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) WatchBuffer(ctx context.Context, in *WatchBufferRequest, opts ...grpc.CallOption) (Proxy_WatchBufferClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Proxy_serviceDesc.Streams[0], "/proto.Proxy/WatchBuffer", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &proxyWatchBufferClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Proxy_WatchBufferClient interface {
+	Recv() (*BufferEvent, error)
+	grpc.ClientStream
+}
+
+type proxyWatchBufferClient struct {
+	grpc.ClientStream
+}
+
+func (x *proxyWatchBufferClient) Recv() (*BufferEvent, error) {
+	m := new(BufferEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ProxyServer is the server API for Proxy service.
 // All implementations must embed UnimplementedProxyServer
 // for forward compatibility
 type ProxyServer interface {
 	GetBufInfo(context.Context, *GetBufInfoRequest) (*GetBufInfoResponse, error)
+	GetLines(context.Context, *GetLinesRequest) (*GetLinesResponse, error)
+	SetLines(context.Context, *SetLinesRequest) (*SetLinesResponse, error)
+	InsertLines(context.Context, *InsertLinesRequest) (*InsertLinesResponse, error)
+	DeleteLines(context.Context, *DeleteLinesRequest) (*DeleteLinesResponse, error)
+	WatchBuffer(*WatchBufferRequest, Proxy_WatchBufferServer) error
 	mustEmbedUnimplementedProxyServer()
 }
 
@@ -52,6 +130,21 @@ type UnimplementedProxyServer struct {
 func (UnimplementedProxyServer) GetBufInfo(context.Context, *GetBufInfoRequest) (*GetBufInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBufInfo not implemented")
 }
+func (UnimplementedProxyServer) GetLines(context.Context, *GetLinesRequest) (*GetLinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLines not implemented")
+}
+func (UnimplementedProxyServer) SetLines(context.Context, *SetLinesRequest) (*SetLinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLines not implemented")
+}
+func (UnimplementedProxyServer) InsertLines(context.Context, *InsertLinesRequest) (*InsertLinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertLines not implemented")
+}
+func (UnimplementedProxyServer) DeleteLines(context.Context, *DeleteLinesRequest) (*DeleteLinesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLines not implemented")
+}
+func (UnimplementedProxyServer) WatchBuffer(*WatchBufferRequest, Proxy_WatchBufferServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBuffer not implemented")
+}
 func (UnimplementedProxyServer) mustEmbedUnimplementedProxyServer() {}
 
 // UnsafeProxyServer may be embedded to opt out of forward compatibility for this service.
@@ -83,6 +176,99 @@ func _Proxy_GetBufInfo_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Proxy_GetLines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).GetLines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Proxy/GetLines",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).GetLines(ctx, req.(*GetLinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_SetLines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).SetLines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Proxy/SetLines",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).SetLines(ctx, req.(*SetLinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_InsertLines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertLinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).InsertLines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Proxy/InsertLines",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).InsertLines(ctx, req.(*InsertLinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_DeleteLines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLinesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).DeleteLines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Proxy/DeleteLines",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).DeleteLines(ctx, req.(*DeleteLinesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_WatchBuffer_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBufferRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProxyServer).WatchBuffer(m, &proxyWatchBufferServer{stream})
+}
+
+type Proxy_WatchBufferServer interface {
+	Send(*BufferEvent) error
+	grpc.ServerStream
+}
+
+type proxyWatchBufferServer struct {
+	grpc.ServerStream
+}
+
+func (x *proxyWatchBufferServer) Send(m *BufferEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Proxy_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "proto.Proxy",
 	HandlerType: (*ProxyServer)(nil),
@@ -91,7 +277,29 @@ var _Proxy_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBufInfo",
 			Handler:    _Proxy_GetBufInfo_Handler,
 		},
+		{
+			MethodName: "GetLines",
+			Handler:    _Proxy_GetLines_Handler,
+		},
+		{
+			MethodName: "SetLines",
+			Handler:    _Proxy_SetLines_Handler,
+		},
+		{
+			MethodName: "InsertLines",
+			Handler:    _Proxy_InsertLines_Handler,
+		},
+		{
+			MethodName: "DeleteLines",
+			Handler:    _Proxy_DeleteLines_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchBuffer",
+			Handler:       _Proxy_WatchBuffer_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
 }
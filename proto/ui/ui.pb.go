@@ -0,0 +1,927 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: ui/ui.proto
+
+package ui
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// NotifyRequest defines the Notify rpc arguments.
+type NotifyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lines []string `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	Title string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// milliseconds before the notification closes, zero
+	// for Vim's default.
+	TimeMs int64 `protobuf:"varint,3,opt,name=time_ms,json=timeMs,proto3" json:"time_ms,omitempty"`
+	// highlight group of the notification, empty for Vim's default.
+	Highlight string `protobuf:"bytes,4,opt,name=highlight,proto3" json:"highlight,omitempty"`
+}
+
+func (x *NotifyRequest) Reset() {
+	*x = NotifyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ui_ui_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotifyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyRequest) ProtoMessage() {}
+
+func (x *NotifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyRequest.ProtoReflect.Descriptor instead.
+func (*NotifyRequest) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NotifyRequest) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *NotifyRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NotifyRequest) GetTimeMs() int64 {
+	if x != nil {
+		return x.TimeMs
+	}
+	return 0
+}
+
+func (x *NotifyRequest) GetHighlight() string {
+	if x != nil {
+		return x.Highlight
+	}
+	return ""
+}
+
+// NotifyResponse defines the Notify rpc response.
+type NotifyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PopupId int64 `protobuf:"varint,1,opt,name=popup_id,json=popupId,proto3" json:"popup_id,omitempty"`
+}
+
+func (x *NotifyResponse) Reset() {
+	*x = NotifyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ui_ui_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotifyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyResponse) ProtoMessage() {}
+
+func (x *NotifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyResponse.ProtoReflect.Descriptor instead.
+func (*NotifyResponse) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotifyResponse) GetPopupId() int64 {
+	if x != nil {
+		return x.PopupId
+	}
+	return 0
+}
+
+// ShowInfoRequest defines the ShowInfo rpc arguments.
+//
+// The info box is displayed next to the cursor and closes
+// when the cursor moves.
+type ShowInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lines []string `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	Title string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *ShowInfoRequest) Reset() {
+	*x = ShowInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ui_ui_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShowInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowInfoRequest) ProtoMessage() {}
+
+func (x *ShowInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowInfoRequest.ProtoReflect.Descriptor instead.
+func (*ShowInfoRequest) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShowInfoRequest) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *ShowInfoRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+// ShowMenuRequest defines the ShowMenu rpc arguments.
+type ShowMenuRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []string `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Title string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *ShowMenuRequest) Reset() {
+	*x = ShowMenuRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ui_ui_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShowMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowMenuRequest) ProtoMessage() {}
+
+func (x *ShowMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowMenuRequest.ProtoReflect.Descriptor instead.
+func (*ShowMenuRequest) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ShowMenuRequest) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ShowMenuRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+// PopupEvent is a OneOf holding the messages streamed to
+// the extension which opened a popup.
+type PopupEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*PopupEvent_Opened
+	//	*PopupEvent_Closed
+	Event isPopupEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *PopupEvent) Reset() {
+	*x = PopupEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ui_ui_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PopupEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopupEvent) ProtoMessage() {}
+
+func (x *PopupEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopupEvent.ProtoReflect.Descriptor instead.
+func (*PopupEvent) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *PopupEvent) GetEvent() isPopupEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *PopupEvent) GetOpened() *PopupOpened {
+	if x, ok := x.GetEvent().(*PopupEvent_Opened); ok {
+		return x.Opened
+	}
+	return nil
+}
+
+func (x *PopupEvent) GetClosed() *PopupClosed {
+	if x, ok := x.GetEvent().(*PopupEvent_Closed); ok {
+		return x.Closed
+	}
+	return nil
+}
+
+type isPopupEvent_Event interface {
+	isPopupEvent_Event()
+}
+
+type PopupEvent_Opened struct {
+	Opened *PopupOpened `protobuf:"bytes,1,opt,name=opened,proto3,oneof"`
+}
+
+type PopupEvent_Closed struct {
+	Closed *PopupClosed `protobuf:"bytes,2,opt,name=closed,proto3,oneof"`
+}
+
+func (*PopupEvent_Opened) isPopupEvent_Event() {}
+
+func (*PopupEvent_Closed) isPopupEvent_Event() {}
+
+// PopupOpened reports the popup was displayed.
+type PopupOpened struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PopupId int64 `protobuf:"varint,1,opt,name=popup_id,json=popupId,proto3" json:"popup_id,omitempty"`
+}
+
+func (x *PopupOpened) Reset() {
+	*x = PopupOpened{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ui_ui_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PopupOpened) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopupOpened) ProtoMessage() {}
+
+func (x *PopupOpened) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopupOpened.ProtoReflect.Descriptor instead.
+func (*PopupOpened) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PopupOpened) GetPopupId() int64 {
+	if x != nil {
+		return x.PopupId
+	}
+	return 0
+}
+
+// PopupClosed reports the popup was closed, either by a selection
+// or by the user dismissing it.
+type PopupClosed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PopupId   int64 `protobuf:"varint,1,opt,name=popup_id,json=popupId,proto3" json:"popup_id,omitempty"`
+	Dismissed bool  `protobuf:"varint,2,opt,name=dismissed,proto3" json:"dismissed,omitempty"`
+	// one-based index of the selected menu item.
+	Index int64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// the selected menu item.
+	Item string `protobuf:"bytes,4,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *PopupClosed) Reset() {
+	*x = PopupClosed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ui_ui_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PopupClosed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopupClosed) ProtoMessage() {}
+
+func (x *PopupClosed) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopupClosed.ProtoReflect.Descriptor instead.
+func (*PopupClosed) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PopupClosed) GetPopupId() int64 {
+	if x != nil {
+		return x.PopupId
+	}
+	return 0
+}
+
+func (x *PopupClosed) GetDismissed() bool {
+	if x != nil {
+		return x.Dismissed
+	}
+	return false
+}
+
+func (x *PopupClosed) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PopupClosed) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+// OpenPopupRequest asks Vim to open a popup, the token is
+// included in the popup's PopupCallback.
+type OpenPopupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token int64 `protobuf:"varint,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Types that are assignable to Popup:
+	//	*OpenPopupRequest_Info
+	//	*OpenPopupRequest_Menu
+	Popup isOpenPopupRequest_Popup `protobuf_oneof:"popup"`
+}
+
+func (x *OpenPopupRequest) Reset() {
+	*x = OpenPopupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ui_ui_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenPopupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenPopupRequest) ProtoMessage() {}
+
+func (x *OpenPopupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenPopupRequest.ProtoReflect.Descriptor instead.
+func (*OpenPopupRequest) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OpenPopupRequest) GetToken() int64 {
+	if x != nil {
+		return x.Token
+	}
+	return 0
+}
+
+func (m *OpenPopupRequest) GetPopup() isOpenPopupRequest_Popup {
+	if m != nil {
+		return m.Popup
+	}
+	return nil
+}
+
+func (x *OpenPopupRequest) GetInfo() *ShowInfoRequest {
+	if x, ok := x.GetPopup().(*OpenPopupRequest_Info); ok {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *OpenPopupRequest) GetMenu() *ShowMenuRequest {
+	if x, ok := x.GetPopup().(*OpenPopupRequest_Menu); ok {
+		return x.Menu
+	}
+	return nil
+}
+
+type isOpenPopupRequest_Popup interface {
+	isOpenPopupRequest_Popup()
+}
+
+type OpenPopupRequest_Info struct {
+	Info *ShowInfoRequest `protobuf:"bytes,2,opt,name=info,proto3,oneof"`
+}
+
+type OpenPopupRequest_Menu struct {
+	Menu *ShowMenuRequest `protobuf:"bytes,3,opt,name=menu,proto3,oneof"`
+}
+
+func (*OpenPopupRequest_Info) isOpenPopupRequest_Popup() {}
+
+func (*OpenPopupRequest_Menu) isOpenPopupRequest_Popup() {}
+
+// ClosePopupRequest asks Vim to close a popup.
+type ClosePopupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PopupId int64 `protobuf:"varint,1,opt,name=popup_id,json=popupId,proto3" json:"popup_id,omitempty"`
+}
+
+func (x *ClosePopupRequest) Reset() {
+	*x = ClosePopupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ui_ui_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClosePopupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClosePopupRequest) ProtoMessage() {}
+
+func (x *ClosePopupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClosePopupRequest.ProtoReflect.Descriptor instead.
+func (*ClosePopupRequest) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ClosePopupRequest) GetPopupId() int64 {
+	if x != nil {
+		return x.PopupId
+	}
+	return 0
+}
+
+// PopupCallback is broadcast by Vim when a popup opened by
+// an OpenPopupRequest closes.
+type PopupCallback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token   int64 `protobuf:"varint,1,opt,name=token,proto3" json:"token,omitempty"`
+	PopupId int64 `protobuf:"varint,2,opt,name=popup_id,json=popupId,proto3" json:"popup_id,omitempty"`
+	// the popup's close result, -1 when dismissed.
+	Result int64 `protobuf:"varint,3,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *PopupCallback) Reset() {
+	*x = PopupCallback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ui_ui_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PopupCallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PopupCallback) ProtoMessage() {}
+
+func (x *PopupCallback) ProtoReflect() protoreflect.Message {
+	mi := &file_ui_ui_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PopupCallback.ProtoReflect.Descriptor instead.
+func (*PopupCallback) Descriptor() ([]byte, []int) {
+	return file_ui_ui_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PopupCallback) GetToken() int64 {
+	if x != nil {
+		return x.Token
+	}
+	return 0
+}
+
+func (x *PopupCallback) GetPopupId() int64 {
+	if x != nil {
+		return x.PopupId
+	}
+	return 0
+}
+
+func (x *PopupCallback) GetResult() int64 {
+	if x != nil {
+		return x.Result
+	}
+	return 0
+}
+
+var File_ui_ui_proto protoreflect.FileDescriptor
+
+var file_ui_ui_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x75, 0x69, 0x2f, 0x75, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x75,
+	0x69, 0x22, 0x72, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x69, 0x67, 0x68, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x69, 0x67, 0x68,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x22, 0x2b, 0x0a, 0x0e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x70, 0x75, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x6f, 0x70, 0x75, 0x70,
+	0x49, 0x64, 0x22, 0x3d, 0x0a, 0x0f, 0x53, 0x68, 0x6f, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x22, 0x3d, 0x0a, 0x0f, 0x53, 0x68, 0x6f, 0x77, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x22, 0x6b, 0x0a, 0x0a, 0x50, 0x6f, 0x70, 0x75, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x29,
+	0x0a, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x75, 0x69, 0x2e, 0x50, 0x6f, 0x70, 0x75, 0x70, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x69, 0x2e, 0x50,
+	0x6f, 0x70, 0x75, 0x70, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x28, 0x0a,
+	0x0b, 0x50, 0x6f, 0x70, 0x75, 0x70, 0x4f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x70, 0x6f, 0x70, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x70, 0x6f, 0x70, 0x75, 0x70, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x0b, 0x50, 0x6f, 0x70, 0x75, 0x70,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x70, 0x75, 0x70, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x6f, 0x70, 0x75, 0x70, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x87, 0x01, 0x0a, 0x10, 0x4f, 0x70,
+	0x65, 0x6e, 0x50, 0x6f, 0x70, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x29, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x75, 0x69, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12,
+	0x29, 0x0a, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x75, 0x69, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x04, 0x6d, 0x65, 0x6e, 0x75, 0x42, 0x07, 0x0a, 0x05, 0x70, 0x6f,
+	0x70, 0x75, 0x70, 0x22, 0x2e, 0x0a, 0x11, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x50, 0x6f, 0x70, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x70, 0x75,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x6f, 0x70, 0x75,
+	0x70, 0x49, 0x64, 0x22, 0x58, 0x0a, 0x0d, 0x50, 0x6f, 0x70, 0x75, 0x70, 0x43, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f,
+	0x70, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x70, 0x6f,
+	0x70, 0x75, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x2b, 0x5a,
+	0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x64, 0x65, 0x6c,
+	0x6f, 0x73, 0x73, 0x61, 0x2f, 0x76, 0x69, 0x6d, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69,
+	0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x75, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_ui_ui_proto_rawDescOnce sync.Once
+	file_ui_ui_proto_rawDescData = file_ui_ui_proto_rawDesc
+)
+
+func file_ui_ui_proto_rawDescGZIP() []byte {
+	file_ui_ui_proto_rawDescOnce.Do(func() {
+		file_ui_ui_proto_rawDescData = protoimpl.X.CompressGZIP(file_ui_ui_proto_rawDescData)
+	})
+	return file_ui_ui_proto_rawDescData
+}
+
+var file_ui_ui_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_ui_ui_proto_goTypes = []interface{}{
+	(*NotifyRequest)(nil),     // 0: ui.NotifyRequest
+	(*NotifyResponse)(nil),    // 1: ui.NotifyResponse
+	(*ShowInfoRequest)(nil),   // 2: ui.ShowInfoRequest
+	(*ShowMenuRequest)(nil),   // 3: ui.ShowMenuRequest
+	(*PopupEvent)(nil),        // 4: ui.PopupEvent
+	(*PopupOpened)(nil),       // 5: ui.PopupOpened
+	(*PopupClosed)(nil),       // 6: ui.PopupClosed
+	(*OpenPopupRequest)(nil),  // 7: ui.OpenPopupRequest
+	(*ClosePopupRequest)(nil), // 8: ui.ClosePopupRequest
+	(*PopupCallback)(nil),     // 9: ui.PopupCallback
+}
+var file_ui_ui_proto_depIdxs = []int32{
+	5, // 0: ui.PopupEvent.opened:type_name -> ui.PopupOpened
+	6, // 1: ui.PopupEvent.closed:type_name -> ui.PopupClosed
+	2, // 2: ui.OpenPopupRequest.info:type_name -> ui.ShowInfoRequest
+	3, // 3: ui.OpenPopupRequest.menu:type_name -> ui.ShowMenuRequest
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_ui_ui_proto_init() }
+func file_ui_ui_proto_init() {
+	if File_ui_ui_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ui_ui_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ui_ui_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ui_ui_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShowInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ui_ui_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShowMenuRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ui_ui_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PopupEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ui_ui_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PopupOpened); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ui_ui_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PopupClosed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ui_ui_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenPopupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ui_ui_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClosePopupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ui_ui_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PopupCallback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_ui_ui_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*PopupEvent_Opened)(nil),
+		(*PopupEvent_Closed)(nil),
+	}
+	file_ui_ui_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*OpenPopupRequest_Info)(nil),
+		(*OpenPopupRequest_Menu)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ui_ui_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ui_ui_proto_goTypes,
+		DependencyIndexes: file_ui_ui_proto_depIdxs,
+		MessageInfos:      file_ui_ui_proto_msgTypes,
+	}.Build()
+	File_ui_ui_proto = out.File
+	file_ui_ui_proto_rawDesc = nil
+	file_ui_ui_proto_goTypes = nil
+	file_ui_ui_proto_depIdxs = nil
+}
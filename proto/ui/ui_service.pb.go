@@ -0,0 +1,92 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: ui/ui_service.proto
+
+package ui
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+var File_ui_ui_service_proto protoreflect.FileDescriptor
+
+var file_ui_ui_service_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x75, 0x69, 0x2f, 0x75, 0x69, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x75, 0x69, 0x1a, 0x0b, 0x75, 0x69, 0x2f, 0x75, 0x69,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xa1, 0x01, 0x0a, 0x02, 0x55, 0x49, 0x12, 0x31, 0x0a,
+	0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x11, 0x2e, 0x75, 0x69, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x75, 0x69, 0x2e,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x33, 0x0a, 0x08, 0x53, 0x68, 0x6f, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e, 0x75,
+	0x69, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0e, 0x2e, 0x75, 0x69, 0x2e, 0x50, 0x6f, 0x70, 0x75, 0x70, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x33, 0x0a, 0x08, 0x53, 0x68, 0x6f, 0x77, 0x4d, 0x65, 0x6e,
+	0x75, 0x12, 0x13, 0x2e, 0x75, 0x69, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x4d, 0x65, 0x6e, 0x75, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x75, 0x69, 0x2e, 0x50, 0x6f, 0x70, 0x75,
+	0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x64, 0x65, 0x6c, 0x6f, 0x73, 0x73,
+	0x61, 0x2f, 0x76, 0x69, 0x6d, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x6d, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x75, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var file_ui_ui_service_proto_goTypes = []interface{}{
+	(*NotifyRequest)(nil),   // 0: ui.NotifyRequest
+	(*ShowInfoRequest)(nil), // 1: ui.ShowInfoRequest
+	(*ShowMenuRequest)(nil), // 2: ui.ShowMenuRequest
+	(*NotifyResponse)(nil),  // 3: ui.NotifyResponse
+	(*PopupEvent)(nil),      // 4: ui.PopupEvent
+}
+var file_ui_ui_service_proto_depIdxs = []int32{
+	0, // 0: ui.UI.Notify:input_type -> ui.NotifyRequest
+	1, // 1: ui.UI.ShowInfo:input_type -> ui.ShowInfoRequest
+	2, // 2: ui.UI.ShowMenu:input_type -> ui.ShowMenuRequest
+	3, // 3: ui.UI.Notify:output_type -> ui.NotifyResponse
+	4, // 4: ui.UI.ShowInfo:output_type -> ui.PopupEvent
+	4, // 5: ui.UI.ShowMenu:output_type -> ui.PopupEvent
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_ui_ui_service_proto_init() }
+func file_ui_ui_service_proto_init() {
+	if File_ui_ui_service_proto != nil {
+		return
+	}
+	file_ui_ui_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ui_ui_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ui_ui_service_proto_goTypes,
+		DependencyIndexes: file_ui_ui_service_proto_depIdxs,
+	}.Build()
+	File_ui_ui_service_proto = out.File
+	file_ui_ui_service_proto_rawDesc = nil
+	file_ui_ui_service_proto_goTypes = nil
+	file_ui_ui_service_proto_depIdxs = nil
+}
@@ -0,0 +1,224 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package ui
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// UIClient is the client API for UI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UIClient interface {
+	Notify(ctx context.Context, in *NotifyRequest, opts ...grpc.CallOption) (*NotifyResponse, error)
+	ShowInfo(ctx context.Context, in *ShowInfoRequest, opts ...grpc.CallOption) (UI_ShowInfoClient, error)
+	ShowMenu(ctx context.Context, in *ShowMenuRequest, opts ...grpc.CallOption) (UI_ShowMenuClient, error)
+}
+
+type uIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUIClient(cc grpc.ClientConnInterface) UIClient {
+	return &uIClient{cc}
+}
+
+func (c *uIClient) Notify(ctx context.Context, in *NotifyRequest, opts ...grpc.CallOption) (*NotifyResponse, error) {
+	out := new(NotifyResponse)
+	err := c.cc.Invoke(ctx, "/ui.UI/Notify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *uIClient) ShowInfo(ctx context.Context, in *ShowInfoRequest, opts ...grpc.CallOption) (UI_ShowInfoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_UI_serviceDesc.Streams[0], "/ui.UI/ShowInfo", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &uIShowInfoClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UI_ShowInfoClient interface {
+	Recv() (*PopupEvent, error)
+	grpc.ClientStream
+}
+
+type uIShowInfoClient struct {
+	grpc.ClientStream
+}
+
+func (x *uIShowInfoClient) Recv() (*PopupEvent, error) {
+	m := new(PopupEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *uIClient) ShowMenu(ctx context.Context, in *ShowMenuRequest, opts ...grpc.CallOption) (UI_ShowMenuClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_UI_serviceDesc.Streams[1], "/ui.UI/ShowMenu", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &uIShowMenuClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UI_ShowMenuClient interface {
+	Recv() (*PopupEvent, error)
+	grpc.ClientStream
+}
+
+type uIShowMenuClient struct {
+	grpc.ClientStream
+}
+
+func (x *uIShowMenuClient) Recv() (*PopupEvent, error) {
+	m := new(PopupEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UIServer is the server API for UI service.
+// All implementations must embed UnimplementedUIServer
+// for forward compatibility
+type UIServer interface {
+	Notify(context.Context, *NotifyRequest) (*NotifyResponse, error)
+	ShowInfo(*ShowInfoRequest, UI_ShowInfoServer) error
+	ShowMenu(*ShowMenuRequest, UI_ShowMenuServer) error
+	mustEmbedUnimplementedUIServer()
+}
+
+// UnimplementedUIServer must be embedded to have forward compatible implementations.
+type UnimplementedUIServer struct {
+}
+
+func (UnimplementedUIServer) Notify(context.Context, *NotifyRequest) (*NotifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Notify not implemented")
+}
+func (UnimplementedUIServer) ShowInfo(*ShowInfoRequest, UI_ShowInfoServer) error {
+	return status.Errorf(codes.Unimplemented, "method ShowInfo not implemented")
+}
+func (UnimplementedUIServer) ShowMenu(*ShowMenuRequest, UI_ShowMenuServer) error {
+	return status.Errorf(codes.Unimplemented, "method ShowMenu not implemented")
+}
+func (UnimplementedUIServer) mustEmbedUnimplementedUIServer() {}
+
+// UnsafeUIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UIServer will
+// result in compilation errors.
+type UnsafeUIServer interface {
+	mustEmbedUnimplementedUIServer()
+}
+
+func RegisterUIServer(s grpc.ServiceRegistrar, srv UIServer) {
+	s.RegisterService(&_UI_serviceDesc, srv)
+}
+
+func _UI_Notify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UIServer).Notify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ui.UI/Notify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UIServer).Notify(ctx, req.(*NotifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UI_ShowInfo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ShowInfoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UIServer).ShowInfo(m, &uIShowInfoServer{stream})
+}
+
+type UI_ShowInfoServer interface {
+	Send(*PopupEvent) error
+	grpc.ServerStream
+}
+
+type uIShowInfoServer struct {
+	grpc.ServerStream
+}
+
+func (x *uIShowInfoServer) Send(m *PopupEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _UI_ShowMenu_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ShowMenuRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UIServer).ShowMenu(m, &uIShowMenuServer{stream})
+}
+
+type UI_ShowMenuServer interface {
+	Send(*PopupEvent) error
+	grpc.ServerStream
+}
+
+type uIShowMenuServer struct {
+	grpc.ServerStream
+}
+
+func (x *uIShowMenuServer) Send(m *PopupEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _UI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ui.UI",
+	HandlerType: (*UIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Notify",
+			Handler:    _UI_Notify_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ShowInfo",
+			Handler:       _UI_ShowInfo_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ShowMenu",
+			Handler:       _UI_ShowMenu_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ui/ui_service.proto",
+}
@@ -0,0 +1,1635 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: windows/windows.proto
+
+package windows
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type OpenFileRequest_Split int32
+
+const (
+	// open the file in the target window.
+	OpenFileRequest_NONE       OpenFileRequest_Split = 0
+	OpenFileRequest_HORIZONTAL OpenFileRequest_Split = 1
+	OpenFileRequest_VERTICAL   OpenFileRequest_Split = 2
+	// open the file in a new tabpage.
+	OpenFileRequest_TAB OpenFileRequest_Split = 3
+)
+
+// Enum value maps for OpenFileRequest_Split.
+var (
+	OpenFileRequest_Split_name = map[int32]string{
+		0: "NONE",
+		1: "HORIZONTAL",
+		2: "VERTICAL",
+		3: "TAB",
+	}
+	OpenFileRequest_Split_value = map[string]int32{
+		"NONE":       0,
+		"HORIZONTAL": 1,
+		"VERTICAL":   2,
+		"TAB":        3,
+	}
+)
+
+func (x OpenFileRequest_Split) Enum() *OpenFileRequest_Split {
+	p := new(OpenFileRequest_Split)
+	*p = x
+	return p
+}
+
+func (x OpenFileRequest_Split) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OpenFileRequest_Split) Descriptor() protoreflect.EnumDescriptor {
+	return file_windows_windows_proto_enumTypes[0].Descriptor()
+}
+
+func (OpenFileRequest_Split) Type() protoreflect.EnumType {
+	return &file_windows_windows_proto_enumTypes[0]
+}
+
+func (x OpenFileRequest_Split) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OpenFileRequest_Split.Descriptor instead.
+func (OpenFileRequest_Split) EnumDescriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{17, 0}
+}
+
+// Cursor is a cursor position in a window.
+type Cursor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// one-based line number.
+	Lnum int64 `protobuf:"varint,1,opt,name=lnum,proto3" json:"lnum,omitempty"`
+	// one-based byte column.
+	Col int64 `protobuf:"varint,2,opt,name=col,proto3" json:"col,omitempty"`
+}
+
+func (x *Cursor) Reset() {
+	*x = Cursor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cursor) ProtoMessage() {}
+
+func (x *Cursor) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cursor.ProtoReflect.Descriptor instead.
+func (*Cursor) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Cursor) GetLnum() int64 {
+	if x != nil {
+		return x.Lnum
+	}
+	return 0
+}
+
+func (x *Cursor) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+// Window describes a Vim window.
+type Window struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Winid int64 `protobuf:"varint,1,opt,name=winid,proto3" json:"winid,omitempty"`
+	// window number within its tabpage.
+	Winnr int64 `protobuf:"varint,2,opt,name=winnr,proto3" json:"winnr,omitempty"`
+	Tabnr int64 `protobuf:"varint,3,opt,name=tabnr,proto3" json:"tabnr,omitempty"`
+	// buffer displayed in the window.
+	Bufnr  int64   `protobuf:"varint,4,opt,name=bufnr,proto3" json:"bufnr,omitempty"`
+	Width  int64   `protobuf:"varint,5,opt,name=width,proto3" json:"width,omitempty"`
+	Height int64   `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	Cursor *Cursor `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// TRUE if this is the current window.
+	Current bool `protobuf:"varint,8,opt,name=current,proto3" json:"current,omitempty"`
+}
+
+func (x *Window) Reset() {
+	*x = Window{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Window) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Window) ProtoMessage() {}
+
+func (x *Window) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Window.ProtoReflect.Descriptor instead.
+func (*Window) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Window) GetWinid() int64 {
+	if x != nil {
+		return x.Winid
+	}
+	return 0
+}
+
+func (x *Window) GetWinnr() int64 {
+	if x != nil {
+		return x.Winnr
+	}
+	return 0
+}
+
+func (x *Window) GetTabnr() int64 {
+	if x != nil {
+		return x.Tabnr
+	}
+	return 0
+}
+
+func (x *Window) GetBufnr() int64 {
+	if x != nil {
+		return x.Bufnr
+	}
+	return 0
+}
+
+func (x *Window) GetWidth() int64 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Window) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Window) GetCursor() *Cursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *Window) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+// Tabpage describes a Vim tabpage and its windows.
+type Tabpage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tabnr   int64     `protobuf:"varint,1,opt,name=tabnr,proto3" json:"tabnr,omitempty"`
+	Windows []*Window `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows,omitempty"`
+	// TRUE if this is the current tabpage.
+	Current bool `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`
+}
+
+func (x *Tabpage) Reset() {
+	*x = Tabpage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tabpage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tabpage) ProtoMessage() {}
+
+func (x *Tabpage) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tabpage.ProtoReflect.Descriptor instead.
+func (*Tabpage) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Tabpage) GetTabnr() int64 {
+	if x != nil {
+		return x.Tabnr
+	}
+	return 0
+}
+
+func (x *Tabpage) GetWindows() []*Window {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *Tabpage) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+// ListTabpagesRequest defines the ListTabpages rpc arguments.
+type ListTabpagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTabpagesRequest) Reset() {
+	*x = ListTabpagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTabpagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTabpagesRequest) ProtoMessage() {}
+
+func (x *ListTabpagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTabpagesRequest.ProtoReflect.Descriptor instead.
+func (*ListTabpagesRequest) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{3}
+}
+
+// ListTabpagesResponse defines the ListTabpages rpc response.
+type ListTabpagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tabpages []*Tabpage `protobuf:"bytes,1,rep,name=tabpages,proto3" json:"tabpages,omitempty"`
+}
+
+func (x *ListTabpagesResponse) Reset() {
+	*x = ListTabpagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTabpagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTabpagesResponse) ProtoMessage() {}
+
+func (x *ListTabpagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTabpagesResponse.ProtoReflect.Descriptor instead.
+func (*ListTabpagesResponse) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListTabpagesResponse) GetTabpages() []*Tabpage {
+	if x != nil {
+		return x.Tabpages
+	}
+	return nil
+}
+
+// ListWindowsRequest defines the ListWindows rpc arguments.
+type ListWindowsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// only list the windows of this tabpage, zero lists
+	// the windows of every tabpage.
+	Tabnr int64 `protobuf:"varint,1,opt,name=tabnr,proto3" json:"tabnr,omitempty"`
+}
+
+func (x *ListWindowsRequest) Reset() {
+	*x = ListWindowsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWindowsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWindowsRequest) ProtoMessage() {}
+
+func (x *ListWindowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWindowsRequest.ProtoReflect.Descriptor instead.
+func (*ListWindowsRequest) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListWindowsRequest) GetTabnr() int64 {
+	if x != nil {
+		return x.Tabnr
+	}
+	return 0
+}
+
+// ListWindowsResponse defines the ListWindows rpc response.
+type ListWindowsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Windows []*Window `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (x *ListWindowsResponse) Reset() {
+	*x = ListWindowsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWindowsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWindowsResponse) ProtoMessage() {}
+
+func (x *ListWindowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWindowsResponse.ProtoReflect.Descriptor instead.
+func (*ListWindowsResponse) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListWindowsResponse) GetWindows() []*Window {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+// GetCursorRequest defines the GetCursor rpc arguments.
+type GetCursorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// window to query, zero for the current window.
+	Winid int64 `protobuf:"varint,1,opt,name=winid,proto3" json:"winid,omitempty"`
+}
+
+func (x *GetCursorRequest) Reset() {
+	*x = GetCursorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCursorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCursorRequest) ProtoMessage() {}
+
+func (x *GetCursorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCursorRequest.ProtoReflect.Descriptor instead.
+func (*GetCursorRequest) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetCursorRequest) GetWinid() int64 {
+	if x != nil {
+		return x.Winid
+	}
+	return 0
+}
+
+// GetCursorResponse defines the GetCursor rpc response.
+type GetCursorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Winid  int64   `protobuf:"varint,1,opt,name=winid,proto3" json:"winid,omitempty"`
+	Cursor *Cursor `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *GetCursorResponse) Reset() {
+	*x = GetCursorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCursorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCursorResponse) ProtoMessage() {}
+
+func (x *GetCursorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCursorResponse.ProtoReflect.Descriptor instead.
+func (*GetCursorResponse) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetCursorResponse) GetWinid() int64 {
+	if x != nil {
+		return x.Winid
+	}
+	return 0
+}
+
+func (x *GetCursorResponse) GetCursor() *Cursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+// SetCursorRequest defines the SetCursor rpc arguments.
+type SetCursorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// window to move the cursor in, zero for the current window.
+	Winid  int64   `protobuf:"varint,1,opt,name=winid,proto3" json:"winid,omitempty"`
+	Cursor *Cursor `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *SetCursorRequest) Reset() {
+	*x = SetCursorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCursorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCursorRequest) ProtoMessage() {}
+
+func (x *SetCursorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCursorRequest.ProtoReflect.Descriptor instead.
+func (*SetCursorRequest) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetCursorRequest) GetWinid() int64 {
+	if x != nil {
+		return x.Winid
+	}
+	return 0
+}
+
+func (x *SetCursorRequest) GetCursor() *Cursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+// SetCursorResponse defines the SetCursor rpc response.
+type SetCursorResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetCursorResponse) Reset() {
+	*x = SetCursorResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCursorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCursorResponse) ProtoMessage() {}
+
+func (x *SetCursorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCursorResponse.ProtoReflect.Descriptor instead.
+func (*SetCursorResponse) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{10}
+}
+
+// SplitWindowRequest defines the SplitWindow rpc arguments.
+type SplitWindowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// window to split, zero for the current window.
+	Winid int64 `protobuf:"varint,1,opt,name=winid,proto3" json:"winid,omitempty"`
+	// split vertically instead of horizontally.
+	Vertical bool `protobuf:"varint,2,opt,name=vertical,proto3" json:"vertical,omitempty"`
+	// height or width of the new window, zero for Vim's default.
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// buffer to display in the new window, zero to display
+	// the split window's buffer.
+	Bufnr int64 `protobuf:"varint,4,opt,name=bufnr,proto3" json:"bufnr,omitempty"`
+	// move focus to the new window.
+	Focus bool `protobuf:"varint,5,opt,name=focus,proto3" json:"focus,omitempty"`
+}
+
+func (x *SplitWindowRequest) Reset() {
+	*x = SplitWindowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplitWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitWindowRequest) ProtoMessage() {}
+
+func (x *SplitWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitWindowRequest.ProtoReflect.Descriptor instead.
+func (*SplitWindowRequest) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SplitWindowRequest) GetWinid() int64 {
+	if x != nil {
+		return x.Winid
+	}
+	return 0
+}
+
+func (x *SplitWindowRequest) GetVertical() bool {
+	if x != nil {
+		return x.Vertical
+	}
+	return false
+}
+
+func (x *SplitWindowRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *SplitWindowRequest) GetBufnr() int64 {
+	if x != nil {
+		return x.Bufnr
+	}
+	return 0
+}
+
+func (x *SplitWindowRequest) GetFocus() bool {
+	if x != nil {
+		return x.Focus
+	}
+	return false
+}
+
+// SplitWindowResponse defines the SplitWindow rpc response.
+type SplitWindowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id of the new window.
+	Winid int64 `protobuf:"varint,1,opt,name=winid,proto3" json:"winid,omitempty"`
+}
+
+func (x *SplitWindowResponse) Reset() {
+	*x = SplitWindowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplitWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitWindowResponse) ProtoMessage() {}
+
+func (x *SplitWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitWindowResponse.ProtoReflect.Descriptor instead.
+func (*SplitWindowResponse) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SplitWindowResponse) GetWinid() int64 {
+	if x != nil {
+		return x.Winid
+	}
+	return 0
+}
+
+// CloseWindowRequest defines the CloseWindow rpc arguments.
+type CloseWindowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Winid int64 `protobuf:"varint,1,opt,name=winid,proto3" json:"winid,omitempty"`
+	// close the window even if its buffer is modified and not hidden.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *CloseWindowRequest) Reset() {
+	*x = CloseWindowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseWindowRequest) ProtoMessage() {}
+
+func (x *CloseWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseWindowRequest.ProtoReflect.Descriptor instead.
+func (*CloseWindowRequest) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CloseWindowRequest) GetWinid() int64 {
+	if x != nil {
+		return x.Winid
+	}
+	return 0
+}
+
+func (x *CloseWindowRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// CloseWindowResponse defines the CloseWindow rpc response.
+type CloseWindowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CloseWindowResponse) Reset() {
+	*x = CloseWindowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseWindowResponse) ProtoMessage() {}
+
+func (x *CloseWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseWindowResponse.ProtoReflect.Descriptor instead.
+func (*CloseWindowResponse) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{14}
+}
+
+// FocusWindowRequest defines the FocusWindow rpc arguments.
+type FocusWindowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Winid int64 `protobuf:"varint,1,opt,name=winid,proto3" json:"winid,omitempty"`
+}
+
+func (x *FocusWindowRequest) Reset() {
+	*x = FocusWindowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FocusWindowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FocusWindowRequest) ProtoMessage() {}
+
+func (x *FocusWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FocusWindowRequest.ProtoReflect.Descriptor instead.
+func (*FocusWindowRequest) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FocusWindowRequest) GetWinid() int64 {
+	if x != nil {
+		return x.Winid
+	}
+	return 0
+}
+
+// FocusWindowResponse defines the FocusWindow rpc response.
+type FocusWindowResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FocusWindowResponse) Reset() {
+	*x = FocusWindowResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FocusWindowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FocusWindowResponse) ProtoMessage() {}
+
+func (x *FocusWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FocusWindowResponse.ProtoReflect.Descriptor instead.
+func (*FocusWindowResponse) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{16}
+}
+
+// OpenFileRequest defines the OpenFile rpc arguments.
+type OpenFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// line to place the cursor on, zero to keep Vim's default.
+	Lnum int64 `protobuf:"varint,2,opt,name=lnum,proto3" json:"lnum,omitempty"`
+	// column to place the cursor on, zero for the first column.
+	Col int64 `protobuf:"varint,3,opt,name=col,proto3" json:"col,omitempty"`
+	// window to open the file in or split, zero for the current window.
+	Winid int64                 `protobuf:"varint,4,opt,name=winid,proto3" json:"winid,omitempty"`
+	Split OpenFileRequest_Split `protobuf:"varint,5,opt,name=split,proto3,enum=windows.OpenFileRequest_Split" json:"split,omitempty"`
+}
+
+func (x *OpenFileRequest) Reset() {
+	*x = OpenFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenFileRequest) ProtoMessage() {}
+
+func (x *OpenFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenFileRequest.ProtoReflect.Descriptor instead.
+func (*OpenFileRequest) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OpenFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *OpenFileRequest) GetLnum() int64 {
+	if x != nil {
+		return x.Lnum
+	}
+	return 0
+}
+
+func (x *OpenFileRequest) GetCol() int64 {
+	if x != nil {
+		return x.Col
+	}
+	return 0
+}
+
+func (x *OpenFileRequest) GetWinid() int64 {
+	if x != nil {
+		return x.Winid
+	}
+	return 0
+}
+
+func (x *OpenFileRequest) GetSplit() OpenFileRequest_Split {
+	if x != nil {
+		return x.Split
+	}
+	return OpenFileRequest_NONE
+}
+
+// OpenFileResponse defines the OpenFile rpc response.
+type OpenFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// window displaying the file, it has focus.
+	Winid int64 `protobuf:"varint,1,opt,name=winid,proto3" json:"winid,omitempty"`
+	Bufnr int64 `protobuf:"varint,2,opt,name=bufnr,proto3" json:"bufnr,omitempty"`
+}
+
+func (x *OpenFileResponse) Reset() {
+	*x = OpenFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenFileResponse) ProtoMessage() {}
+
+func (x *OpenFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenFileResponse.ProtoReflect.Descriptor instead.
+func (*OpenFileResponse) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OpenFileResponse) GetWinid() int64 {
+	if x != nil {
+		return x.Winid
+	}
+	return 0
+}
+
+func (x *OpenFileResponse) GetBufnr() int64 {
+	if x != nil {
+		return x.Bufnr
+	}
+	return 0
+}
+
+// WindowResult is Vim's reply to the window altering rpcs.
+type WindowResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the window acted on or created, zero if the window
+	// in the request does not exist.
+	Winid int64 `protobuf:"varint,1,opt,name=winid,proto3" json:"winid,omitempty"`
+	Bufnr int64 `protobuf:"varint,2,opt,name=bufnr,proto3" json:"bufnr,omitempty"`
+}
+
+func (x *WindowResult) Reset() {
+	*x = WindowResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_windows_windows_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WindowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindowResult) ProtoMessage() {}
+
+func (x *WindowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_windows_windows_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindowResult.ProtoReflect.Descriptor instead.
+func (*WindowResult) Descriptor() ([]byte, []int) {
+	return file_windows_windows_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *WindowResult) GetWinid() int64 {
+	if x != nil {
+		return x.Winid
+	}
+	return 0
+}
+
+func (x *WindowResult) GetBufnr() int64 {
+	if x != nil {
+		return x.Bufnr
+	}
+	return 0
+}
+
+var File_windows_windows_proto protoreflect.FileDescriptor
+
+var file_windows_windows_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x2f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73,
+	0x22, 0x2e, 0x0a, 0x06, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6e,
+	0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x6e, 0x75, 0x6d, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c,
+	0x22, 0xd1, 0x01, 0x0a, 0x06, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x77,
+	0x69, 0x6e, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x6e, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x6e, 0x6e, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x77, 0x69, 0x6e, 0x6e, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6e, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6e, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75,
+	0x66, 0x6e, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x22, 0x64, 0x0a, 0x07, 0x54, 0x61, 0x62, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6e, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x74, 0x61, 0x62, 0x6e, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73,
+	0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x61, 0x62, 0x70, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x44, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x70, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x74, 0x61, 0x62,
+	0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x2e, 0x54, 0x61, 0x62, 0x70, 0x61, 0x67, 0x65, 0x52, 0x08, 0x74,
+	0x61, 0x62, 0x70, 0x61, 0x67, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x61, 0x62, 0x6e, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x61,
+	0x62, 0x6e, 0x72, 0x22, 0x40, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x07, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x22, 0x28, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x6e,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64, 0x22,
+	0x52, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0x51, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64, 0x12, 0x27, 0x0a,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x12,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x74,
+	0x69, 0x63, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x74,
+	0x69, 0x63, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x66, 0x6e,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6f, 0x63, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66,
+	0x6f, 0x63, 0x75, 0x73, 0x22, 0x2b, 0x0a, 0x13, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77,
+	0x69, 0x6e, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x6e, 0x69,
+	0x64, 0x22, 0x40, 0x0a, 0x12, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x12, 0x46, 0x6f,
+	0x63, 0x75, 0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x77, 0x69, 0x6e, 0x69, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd1, 0x01,
+	0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x6e, 0x75, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6f, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x63, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x77,
+	0x69, 0x6e, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x6e, 0x69,
+	0x64, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1e, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74,
+	0x52, 0x05, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x22, 0x38, 0x0a, 0x05, 0x53, 0x70, 0x6c, 0x69, 0x74,
+	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x48, 0x4f,
+	0x52, 0x49, 0x5a, 0x4f, 0x4e, 0x54, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x45,
+	0x52, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x41, 0x42, 0x10,
+	0x03, 0x22, 0x3e, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62,
+	0x75, 0x66, 0x6e, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75, 0x66, 0x6e,
+	0x72, 0x22, 0x40, 0x0a, 0x0c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x77, 0x69, 0x6e, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75, 0x66, 0x6e, 0x72, 0x4a, 0x04, 0x08,
+	0x03, 0x10, 0x04, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6c, 0x64, 0x65, 0x6c, 0x6f, 0x73, 0x73, 0x61, 0x2f, 0x76, 0x69, 0x6d, 0x2d, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_windows_windows_proto_rawDescOnce sync.Once
+	file_windows_windows_proto_rawDescData = file_windows_windows_proto_rawDesc
+)
+
+func file_windows_windows_proto_rawDescGZIP() []byte {
+	file_windows_windows_proto_rawDescOnce.Do(func() {
+		file_windows_windows_proto_rawDescData = protoimpl.X.CompressGZIP(file_windows_windows_proto_rawDescData)
+	})
+	return file_windows_windows_proto_rawDescData
+}
+
+var file_windows_windows_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_windows_windows_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_windows_windows_proto_goTypes = []interface{}{
+	(OpenFileRequest_Split)(0),   // 0: windows.OpenFileRequest.Split
+	(*Cursor)(nil),               // 1: windows.Cursor
+	(*Window)(nil),               // 2: windows.Window
+	(*Tabpage)(nil),              // 3: windows.Tabpage
+	(*ListTabpagesRequest)(nil),  // 4: windows.ListTabpagesRequest
+	(*ListTabpagesResponse)(nil), // 5: windows.ListTabpagesResponse
+	(*ListWindowsRequest)(nil),   // 6: windows.ListWindowsRequest
+	(*ListWindowsResponse)(nil),  // 7: windows.ListWindowsResponse
+	(*GetCursorRequest)(nil),     // 8: windows.GetCursorRequest
+	(*GetCursorResponse)(nil),    // 9: windows.GetCursorResponse
+	(*SetCursorRequest)(nil),     // 10: windows.SetCursorRequest
+	(*SetCursorResponse)(nil),    // 11: windows.SetCursorResponse
+	(*SplitWindowRequest)(nil),   // 12: windows.SplitWindowRequest
+	(*SplitWindowResponse)(nil),  // 13: windows.SplitWindowResponse
+	(*CloseWindowRequest)(nil),   // 14: windows.CloseWindowRequest
+	(*CloseWindowResponse)(nil),  // 15: windows.CloseWindowResponse
+	(*FocusWindowRequest)(nil),   // 16: windows.FocusWindowRequest
+	(*FocusWindowResponse)(nil),  // 17: windows.FocusWindowResponse
+	(*OpenFileRequest)(nil),      // 18: windows.OpenFileRequest
+	(*OpenFileResponse)(nil),     // 19: windows.OpenFileResponse
+	(*WindowResult)(nil),         // 20: windows.WindowResult
+}
+var file_windows_windows_proto_depIdxs = []int32{
+	1, // 0: windows.Window.cursor:type_name -> windows.Cursor
+	2, // 1: windows.Tabpage.windows:type_name -> windows.Window
+	3, // 2: windows.ListTabpagesResponse.tabpages:type_name -> windows.Tabpage
+	2, // 3: windows.ListWindowsResponse.windows:type_name -> windows.Window
+	1, // 4: windows.GetCursorResponse.cursor:type_name -> windows.Cursor
+	1, // 5: windows.SetCursorRequest.cursor:type_name -> windows.Cursor
+	0, // 6: windows.OpenFileRequest.split:type_name -> windows.OpenFileRequest.Split
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_windows_windows_proto_init() }
+func file_windows_windows_proto_init() {
+	if File_windows_windows_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_windows_windows_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cursor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Window); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tabpage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTabpagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTabpagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWindowsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWindowsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCursorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCursorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCursorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCursorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitWindowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitWindowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseWindowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseWindowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FocusWindowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FocusWindowResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_windows_windows_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WindowResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_windows_windows_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_windows_windows_proto_goTypes,
+		DependencyIndexes: file_windows_windows_proto_depIdxs,
+		EnumInfos:         file_windows_windows_proto_enumTypes,
+		MessageInfos:      file_windows_windows_proto_msgTypes,
+	}.Build()
+	File_windows_windows_proto = out.File
+	file_windows_windows_proto_rawDesc = nil
+	file_windows_windows_proto_goTypes = nil
+	file_windows_windows_proto_depIdxs = nil
+}
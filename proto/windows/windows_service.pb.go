@@ -0,0 +1,143 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: windows/windows_service.proto
+
+package windows
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+var File_windows_windows_service_proto protoreflect.FileDescriptor
+
+var file_windows_windows_service_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x2f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x1a, 0x15, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x73, 0x2f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32,
+	0xd7, 0x04, 0x0a, 0x07, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x4d, 0x0a, 0x0c, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x70, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x70, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x62, 0x70, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x1b, 0x2e, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x19, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x09,
+	0x53, 0x65, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x19, 0x2e, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x2e, 0x53,
+	0x65, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0x1b, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a,
+	0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1b, 0x2e,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x73, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0b, 0x46, 0x6f,
+	0x63, 0x75, 0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1b, 0x2e, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x2e, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73,
+	0x2e, 0x46, 0x6f, 0x63, 0x75, 0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x18, 0x2e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x64, 0x65, 0x6c, 0x6f, 0x73, 0x73, 0x61,
+	0x2f, 0x76, 0x69, 0x6d, 0x2d, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x69, 0x6d, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var file_windows_windows_service_proto_goTypes = []interface{}{
+	(*ListTabpagesRequest)(nil),  // 0: windows.ListTabpagesRequest
+	(*ListWindowsRequest)(nil),   // 1: windows.ListWindowsRequest
+	(*GetCursorRequest)(nil),     // 2: windows.GetCursorRequest
+	(*SetCursorRequest)(nil),     // 3: windows.SetCursorRequest
+	(*SplitWindowRequest)(nil),   // 4: windows.SplitWindowRequest
+	(*CloseWindowRequest)(nil),   // 5: windows.CloseWindowRequest
+	(*FocusWindowRequest)(nil),   // 6: windows.FocusWindowRequest
+	(*OpenFileRequest)(nil),      // 7: windows.OpenFileRequest
+	(*ListTabpagesResponse)(nil), // 8: windows.ListTabpagesResponse
+	(*ListWindowsResponse)(nil),  // 9: windows.ListWindowsResponse
+	(*GetCursorResponse)(nil),    // 10: windows.GetCursorResponse
+	(*SetCursorResponse)(nil),    // 11: windows.SetCursorResponse
+	(*SplitWindowResponse)(nil),  // 12: windows.SplitWindowResponse
+	(*CloseWindowResponse)(nil),  // 13: windows.CloseWindowResponse
+	(*FocusWindowResponse)(nil),  // 14: windows.FocusWindowResponse
+	(*OpenFileResponse)(nil),     // 15: windows.OpenFileResponse
+}
+var file_windows_windows_service_proto_depIdxs = []int32{
+	0,  // 0: windows.Windows.ListTabpages:input_type -> windows.ListTabpagesRequest
+	1,  // 1: windows.Windows.ListWindows:input_type -> windows.ListWindowsRequest
+	2,  // 2: windows.Windows.GetCursor:input_type -> windows.GetCursorRequest
+	3,  // 3: windows.Windows.SetCursor:input_type -> windows.SetCursorRequest
+	4,  // 4: windows.Windows.SplitWindow:input_type -> windows.SplitWindowRequest
+	5,  // 5: windows.Windows.CloseWindow:input_type -> windows.CloseWindowRequest
+	6,  // 6: windows.Windows.FocusWindow:input_type -> windows.FocusWindowRequest
+	7,  // 7: windows.Windows.OpenFile:input_type -> windows.OpenFileRequest
+	8,  // 8: windows.Windows.ListTabpages:output_type -> windows.ListTabpagesResponse
+	9,  // 9: windows.Windows.ListWindows:output_type -> windows.ListWindowsResponse
+	10, // 10: windows.Windows.GetCursor:output_type -> windows.GetCursorResponse
+	11, // 11: windows.Windows.SetCursor:output_type -> windows.SetCursorResponse
+	12, // 12: windows.Windows.SplitWindow:output_type -> windows.SplitWindowResponse
+	13, // 13: windows.Windows.CloseWindow:output_type -> windows.CloseWindowResponse
+	14, // 14: windows.Windows.FocusWindow:output_type -> windows.FocusWindowResponse
+	15, // 15: windows.Windows.OpenFile:output_type -> windows.OpenFileResponse
+	8,  // [8:16] is the sub-list for method output_type
+	0,  // [0:8] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_windows_windows_service_proto_init() }
+func file_windows_windows_service_proto_init() {
+	if File_windows_windows_service_proto != nil {
+		return
+	}
+	file_windows_windows_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_windows_windows_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_windows_windows_service_proto_goTypes,
+		DependencyIndexes: file_windows_windows_service_proto_depIdxs,
+	}.Build()
+	File_windows_windows_service_proto = out.File
+	file_windows_windows_service_proto_rawDesc = nil
+	file_windows_windows_service_proto_goTypes = nil
+	file_windows_windows_service_proto_depIdxs = nil
+}
@@ -0,0 +1,349 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package windows
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// WindowsClient is the client API for Windows service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WindowsClient interface {
+	ListTabpages(ctx context.Context, in *ListTabpagesRequest, opts ...grpc.CallOption) (*ListTabpagesResponse, error)
+	ListWindows(ctx context.Context, in *ListWindowsRequest, opts ...grpc.CallOption) (*ListWindowsResponse, error)
+	GetCursor(ctx context.Context, in *GetCursorRequest, opts ...grpc.CallOption) (*GetCursorResponse, error)
+	SetCursor(ctx context.Context, in *SetCursorRequest, opts ...grpc.CallOption) (*SetCursorResponse, error)
+	SplitWindow(ctx context.Context, in *SplitWindowRequest, opts ...grpc.CallOption) (*SplitWindowResponse, error)
+	CloseWindow(ctx context.Context, in *CloseWindowRequest, opts ...grpc.CallOption) (*CloseWindowResponse, error)
+	FocusWindow(ctx context.Context, in *FocusWindowRequest, opts ...grpc.CallOption) (*FocusWindowResponse, error)
+	OpenFile(ctx context.Context, in *OpenFileRequest, opts ...grpc.CallOption) (*OpenFileResponse, error)
+}
+
+type windowsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWindowsClient(cc grpc.ClientConnInterface) WindowsClient {
+	return &windowsClient{cc}
+}
+
+func (c *windowsClient) ListTabpages(ctx context.Context, in *ListTabpagesRequest, opts ...grpc.CallOption) (*ListTabpagesResponse, error) {
+	out := new(ListTabpagesResponse)
+	err := c.cc.Invoke(ctx, "/windows.Windows/ListTabpages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *windowsClient) ListWindows(ctx context.Context, in *ListWindowsRequest, opts ...grpc.CallOption) (*ListWindowsResponse, error) {
+	out := new(ListWindowsResponse)
+	err := c.cc.Invoke(ctx, "/windows.Windows/ListWindows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *windowsClient) GetCursor(ctx context.Context, in *GetCursorRequest, opts ...grpc.CallOption) (*GetCursorResponse, error) {
+	out := new(GetCursorResponse)
+	err := c.cc.Invoke(ctx, "/windows.Windows/GetCursor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *windowsClient) SetCursor(ctx context.Context, in *SetCursorRequest, opts ...grpc.CallOption) (*SetCursorResponse, error) {
+	out := new(SetCursorResponse)
+	err := c.cc.Invoke(ctx, "/windows.Windows/SetCursor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *windowsClient) SplitWindow(ctx context.Context, in *SplitWindowRequest, opts ...grpc.CallOption) (*SplitWindowResponse, error) {
+	out := new(SplitWindowResponse)
+	err := c.cc.Invoke(ctx, "/windows.Windows/SplitWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *windowsClient) CloseWindow(ctx context.Context, in *CloseWindowRequest, opts ...grpc.CallOption) (*CloseWindowResponse, error) {
+	out := new(CloseWindowResponse)
+	err := c.cc.Invoke(ctx, "/windows.Windows/CloseWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *windowsClient) FocusWindow(ctx context.Context, in *FocusWindowRequest, opts ...grpc.CallOption) (*FocusWindowResponse, error) {
+	out := new(FocusWindowResponse)
+	err := c.cc.Invoke(ctx, "/windows.Windows/FocusWindow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *windowsClient) OpenFile(ctx context.Context, in *OpenFileRequest, opts ...grpc.CallOption) (*OpenFileResponse, error) {
+	out := new(OpenFileResponse)
+	err := c.cc.Invoke(ctx, "/windows.Windows/OpenFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WindowsServer is the server API for Windows service.
+// All implementations must embed UnimplementedWindowsServer
+// for forward compatibility
+type WindowsServer interface {
+	ListTabpages(context.Context, *ListTabpagesRequest) (*ListTabpagesResponse, error)
+	ListWindows(context.Context, *ListWindowsRequest) (*ListWindowsResponse, error)
+	GetCursor(context.Context, *GetCursorRequest) (*GetCursorResponse, error)
+	SetCursor(context.Context, *SetCursorRequest) (*SetCursorResponse, error)
+	SplitWindow(context.Context, *SplitWindowRequest) (*SplitWindowResponse, error)
+	CloseWindow(context.Context, *CloseWindowRequest) (*CloseWindowResponse, error)
+	FocusWindow(context.Context, *FocusWindowRequest) (*FocusWindowResponse, error)
+	OpenFile(context.Context, *OpenFileRequest) (*OpenFileResponse, error)
+	mustEmbedUnimplementedWindowsServer()
+}
+
+// UnimplementedWindowsServer must be embedded to have forward compatible implementations.
+type UnimplementedWindowsServer struct {
+}
+
+func (UnimplementedWindowsServer) ListTabpages(context.Context, *ListTabpagesRequest) (*ListTabpagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTabpages not implemented")
+}
+func (UnimplementedWindowsServer) ListWindows(context.Context, *ListWindowsRequest) (*ListWindowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWindows not implemented")
+}
+func (UnimplementedWindowsServer) GetCursor(context.Context, *GetCursorRequest) (*GetCursorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCursor not implemented")
+}
+func (UnimplementedWindowsServer) SetCursor(context.Context, *SetCursorRequest) (*SetCursorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCursor not implemented")
+}
+func (UnimplementedWindowsServer) SplitWindow(context.Context, *SplitWindowRequest) (*SplitWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitWindow not implemented")
+}
+func (UnimplementedWindowsServer) CloseWindow(context.Context, *CloseWindowRequest) (*CloseWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseWindow not implemented")
+}
+func (UnimplementedWindowsServer) FocusWindow(context.Context, *FocusWindowRequest) (*FocusWindowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FocusWindow not implemented")
+}
+func (UnimplementedWindowsServer) OpenFile(context.Context, *OpenFileRequest) (*OpenFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenFile not implemented")
+}
+func (UnimplementedWindowsServer) mustEmbedUnimplementedWindowsServer() {}
+
+// UnsafeWindowsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WindowsServer will
+// result in compilation errors.
+type UnsafeWindowsServer interface {
+	mustEmbedUnimplementedWindowsServer()
+}
+
+func RegisterWindowsServer(s grpc.ServiceRegistrar, srv WindowsServer) {
+	s.RegisterService(&_Windows_serviceDesc, srv)
+}
+
+func _Windows_ListTabpages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTabpagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WindowsServer).ListTabpages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/windows.Windows/ListTabpages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WindowsServer).ListTabpages(ctx, req.(*ListTabpagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Windows_ListWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WindowsServer).ListWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/windows.Windows/ListWindows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WindowsServer).ListWindows(ctx, req.(*ListWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Windows_GetCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCursorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WindowsServer).GetCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/windows.Windows/GetCursor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WindowsServer).GetCursor(ctx, req.(*GetCursorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Windows_SetCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCursorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WindowsServer).SetCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/windows.Windows/SetCursor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WindowsServer).SetCursor(ctx, req.(*SetCursorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Windows_SplitWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WindowsServer).SplitWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/windows.Windows/SplitWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WindowsServer).SplitWindow(ctx, req.(*SplitWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Windows_CloseWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WindowsServer).CloseWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/windows.Windows/CloseWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WindowsServer).CloseWindow(ctx, req.(*CloseWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Windows_FocusWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FocusWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WindowsServer).FocusWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/windows.Windows/FocusWindow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WindowsServer).FocusWindow(ctx, req.(*FocusWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Windows_OpenFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WindowsServer).OpenFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/windows.Windows/OpenFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WindowsServer).OpenFile(ctx, req.(*OpenFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Windows_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "windows.Windows",
+	HandlerType: (*WindowsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTabpages",
+			Handler:    _Windows_ListTabpages_Handler,
+		},
+		{
+			MethodName: "ListWindows",
+			Handler:    _Windows_ListWindows_Handler,
+		},
+		{
+			MethodName: "GetCursor",
+			Handler:    _Windows_GetCursor_Handler,
+		},
+		{
+			MethodName: "SetCursor",
+			Handler:    _Windows_SetCursor_Handler,
+		},
+		{
+			MethodName: "SplitWindow",
+			Handler:    _Windows_SplitWindow_Handler,
+		},
+		{
+			MethodName: "CloseWindow",
+			Handler:    _Windows_CloseWindow_Handler,
+		},
+		{
+			MethodName: "FocusWindow",
+			Handler:    _Windows_FocusWindow_Handler,
+		},
+		{
+			MethodName: "OpenFile",
+			Handler:    _Windows_OpenFile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "windows/windows_service.proto",
+}
@@ -0,0 +1,359 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/ldelossa/vim-grpc.vim/channel"
+	pb "github.com/ldelossa/vim-grpc.vim/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// bufWatcher is a WatchBuffer stream awaiting changes
+// to a buffer.
+type bufWatcher struct {
+	events chan *pb.BufferChange
+	// closed when the watcher falls behind and events
+	// could no longer be delivered.
+	overflow chan struct{}
+	once     sync.Once
+}
+
+// deliver hands a change to the watcher without blocking.
+func (w *bufWatcher) deliver(change *pb.BufferChange) {
+	select {
+	case w.events <- change:
+	default:
+		w.once.Do(func() { close(w.overflow) })
+	}
+}
+
+// bufferKey identifies a buffer, buffer numbers are local to a session.
+type bufferKey struct {
+	session string
+	bufnr   int64
+}
+
+// BufferService provides RPCs for inspecting and editing the buffers
+// open in the current Vim session.
+//
+// BufferService monitors the channel's buffer event mailboxes and
+// forwards buffer changes to watching clients.
+type BufferService struct {
+	*Proxy
+	pb.UnimplementedProxyServer
+	sync.Mutex
+	watchers map[bufferKey]map[*bufWatcher]struct{}
+	// held from registering a watcher, or removing the last
+	// watcher of a buffer, until Vim detached from the buffer,
+	// so a new watcher never has its listener removed.
+	attach sync.Mutex
+}
+
+func NewBufferService(ctx context.Context, proxy *Proxy) *BufferService {
+	bs := &BufferService{
+		Proxy:    proxy,
+		watchers: map[bufferKey]map[*bufWatcher]struct{}{},
+	}
+	return bs
+}
+
+// monitor watches the provided mailbox number of a session for incoming BufferChanged rpcs.
+//
+// when monitor encounters a BufferChanged rpc it will forward the change to
+// every client watching the buffer.
+func (b *BufferService) monitor(ctx context.Context, s *Session, boxNumber uint32) {
+	for ctx.Err() == nil && s.channel.ChannelOpen() {
+		d := channel.Delivery{Channel: s.channel, BoxNum: boxNumber}
+		env, err := d.Wait(ctx)
+		if err != nil {
+			log.Printf("BufferService: received error waiting on mailbox %v: %v", boxNumber, err)
+			continue
+		}
+
+		change := &pb.BufferChange{}
+		err = jsonpb.Unmarshal(bytes.NewReader(env.Body), change)
+		if err != nil {
+			log.Printf("BufferService: received error serializing json to BufferChange event %v: %v", boxNumber, err)
+			continue
+		}
+
+		b.Lock()
+		for w := range b.watchers[bufferKey{session: s.info.Id, bufnr: change.Bufnr}] {
+			w.deliver(change)
+		}
+		b.Unlock()
+	}
+	log.Printf("BufferService: session %v monitor done: %v", s.info.Id, ctx.Err())
+}
+
+// GetBufInfo returns information about the buffers open in Vim.
+//
+// If the request identifies a buffer by number or by name only that
+// buffer is returned and a NotFound error is returned if it does not exist.
+// A request without a buffer identifier returns all buffers.
+func (b *BufferService) GetBufInfo(ctx context.Context, req *pb.GetBufInfoRequest) (*pb.GetBufInfoResponse, error) {
+	const (
+		RPC = "GetBufInfo"
+	)
+
+	switch id := req.BufferId.(type) {
+	case *pb.GetBufInfoRequest_Bufn:
+		if id.Bufn <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid buffer number: %v", id.Bufn)
+		}
+	case *pb.GetBufInfoRequest_BufName:
+		if id.BufName == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "buffer name must not be empty")
+		}
+	}
+
+	resp := &pb.GetBufInfoResponse{}
+	err := b.call(ctx, RPC, req, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.BufferId != nil && len(resp.Buffers) == 0 {
+		return nil, status.Errorf(codes.NotFound, "buffer not found")
+	}
+	return resp, nil
+}
+
+// GetLines returns the requested range of lines from a buffer along
+// with the changedtick the lines were read at.
+//
+// The returned changedtick may be provided to the buffer editing RPCs.
+func (b *BufferService) GetLines(ctx context.Context, req *pb.GetLinesRequest) (*pb.GetLinesResponse, error) {
+	const (
+		RPC = "GetLines"
+	)
+
+	if err := validRange(req.Bufnr, req.Start, req.End); err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetLinesResponse{}
+	err := b.call(ctx, RPC, req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SetLines replaces a range of lines in a buffer.
+//
+// The edit is rejected with an Aborted error if the buffer's
+// changedtick does not match the one provided.
+func (b *BufferService) SetLines(ctx context.Context, req *pb.SetLinesRequest) (*pb.SetLinesResponse, error) {
+	const (
+		RPC = "SetLines"
+	)
+
+	if err := validRange(req.Bufnr, req.Start, req.End); err != nil {
+		return nil, err
+	}
+
+	tick, err := b.edit(ctx, RPC, req, req.ChangedTick)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetLinesResponse{ChangedTick: tick}, nil
+}
+
+// InsertLines inserts lines below the provided line number.
+//
+// The edit is rejected with an Aborted error if the buffer's
+// changedtick does not match the one provided.
+func (b *BufferService) InsertLines(ctx context.Context, req *pb.InsertLinesRequest) (*pb.InsertLinesResponse, error) {
+	const (
+		RPC = "InsertLines"
+	)
+
+	if req.Bufnr <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid buffer number: %v", req.Bufnr)
+	}
+	if req.Lnum < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid line number: %v", req.Lnum)
+	}
+
+	tick, err := b.edit(ctx, RPC, req, req.ChangedTick)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.InsertLinesResponse{ChangedTick: tick}, nil
+}
+
+// DeleteLines deletes a range of lines from a buffer.
+//
+// The edit is rejected with an Aborted error if the buffer's
+// changedtick does not match the one provided.
+func (b *BufferService) DeleteLines(ctx context.Context, req *pb.DeleteLinesRequest) (*pb.DeleteLinesResponse, error) {
+	const (
+		RPC = "DeleteLines"
+	)
+
+	if err := validRange(req.Bufnr, req.Start, req.End); err != nil {
+		return nil, err
+	}
+
+	tick, err := b.edit(ctx, RPC, req, req.ChangedTick)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteLinesResponse{ChangedTick: tick}, nil
+}
+
+// WatchBuffer streams every change made to a buffer until the client
+// disconnects or the channel to Vim closes.
+//
+// If requested the stream begins with a snapshot of the buffer's contents.
+// Changes are only streamed if they occurred after the snapshot was taken.
+//
+// A watcher which cannot keep up with the buffer's changes is disconnected
+// with a ResourceExhausted error, as the deltas it received would no longer
+// describe the buffer.
+func (b *BufferService) WatchBuffer(req *pb.WatchBufferRequest, stream pb.Proxy_WatchBufferServer) error {
+	const (
+		RPC        = "WatchBuffer"
+		UnwatchRPC = "UnwatchBuffer"
+		// buffered changes before a watcher is considered behind.
+		backlog = 1024
+	)
+
+	if req.Bufnr <= 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid buffer number: %v", req.Bufnr)
+	}
+
+	s, err := b.session(stream.Context())
+	if err != nil {
+		return err
+	}
+	ch := s.channel
+	if !ch.ChannelOpen() {
+		return channel.ErrChanClosed
+	}
+	ctx := withSession(stream.Context(), s.info.Id)
+	key := bufferKey{session: s.info.Id, bufnr: req.Bufnr}
+
+	// register before attaching so no change between attaching and the
+	// snapshot is missed.
+	w := &bufWatcher{
+		events:   make(chan *pb.BufferChange, backlog),
+		overflow: make(chan struct{}),
+	}
+	b.attach.Lock()
+	b.Lock()
+	if b.watchers[key] == nil {
+		b.watchers[key] = map[*bufWatcher]struct{}{}
+	}
+	b.watchers[key][w] = struct{}{}
+	b.Unlock()
+	b.attach.Unlock()
+
+	defer func() {
+		b.attach.Lock()
+		defer b.attach.Unlock()
+		b.Lock()
+		delete(b.watchers[key], w)
+		last := len(b.watchers[key]) == 0
+		if last {
+			delete(b.watchers, key)
+		}
+		b.Unlock()
+		if !last {
+			return
+		}
+		// detach from the buffer once its last watcher leaves, a
+		// watcher registering meanwhile waits for the detach and
+		// attaches again.
+		ctx, cancel := context.WithTimeout(withSession(context.Background(), s.info.Id), 5*time.Second)
+		defer cancel()
+		if err := b.call(ctx, UnwatchRPC, req, &pb.BufferSnapshot{}); err != nil {
+			log.Printf("BufferService: failed to detach from buffer %v: %v", req.Bufnr, err)
+		}
+	}()
+
+	snapshot := &pb.BufferSnapshot{}
+	err = b.call(ctx, RPC, req, snapshot)
+	if err != nil {
+		return err
+	}
+
+	if req.Snapshot {
+		err = stream.Send(&pb.BufferEvent{
+			Event: &pb.BufferEvent_Snapshot{Snapshot: snapshot},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-w.overflow:
+			return status.Errorf(codes.ResourceExhausted, "watcher fell behind buffer %v changes", req.Bufnr)
+		case <-ch.Done():
+			return status.Errorf(codes.Unavailable, "channel closed while watching buffer %v", req.Bufnr)
+		case change := <-w.events:
+			if change.ChangedTick <= snapshot.ChangedTick {
+				continue
+			}
+			err = stream.Send(&pb.BufferEvent{
+				Event: &pb.BufferEvent_Change{Change: change},
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// edit issues a buffer editing RPC and maps Vim's BufferEditResult
+// to the buffer's new changedtick or a gRPC status error.
+func (b *BufferService) edit(ctx context.Context, rpc string, req proto.Message, tick int64) (int64, error) {
+	if tick <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "changed_tick is required")
+	}
+
+	res := &pb.BufferEditResult{}
+	err := b.call(ctx, rpc, req, res)
+	if err != nil {
+		return 0, err
+	}
+
+	switch res.Status {
+	case pb.BufferEditResult_APPLIED:
+		return res.ChangedTick, nil
+	case pb.BufferEditResult_CONFLICT:
+		return 0, status.Errorf(codes.Aborted, "buffer changed: expected changedtick %v, buffer is at %v", tick, res.ChangedTick)
+	case pb.BufferEditResult_NOT_FOUND:
+		return 0, status.Errorf(codes.NotFound, "buffer not found")
+	case pb.BufferEditResult_INVALID_RANGE:
+		return 0, status.Errorf(codes.OutOfRange, "line range outside of buffer")
+	}
+	return 0, status.Errorf(codes.Internal, "unknown edit status: %v", res.Status)
+}
+
+// validRange validates the buffer number and one-based
+// line range of a request.
+func validRange(bufnr, start, end int64) error {
+	if bufnr <= 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid buffer number: %v", bufnr)
+	}
+	if start <= 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid start line: %v", start)
+	}
+	if end != 0 && end < start {
+		return status.Errorf(codes.InvalidArgument, "end line %v before start line %v", end, start)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ldelossa/vim-grpc.vim/proto"
+	editor "github.com/ldelossa/vim-grpc.vim/proto/editor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestWatchBufferDisconnect watches a buffer and edits it after Vim
+// disconnects, the watcher must fail and the edit must not raise.
+func TestWatchBufferDisconnect(t *testing.T) {
+	conn := startVim(t)
+	client := proto.NewProxyClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	execute(t, conn, "enew", "call setline(1, ['one', 'two'])")
+	bufnr := int64(eval(t, conn, "bufnr()").(float64))
+	stream, err := client.WatchBuffer(ctx, &proto.WatchBufferRequest{Bufnr: bufnr, Snapshot: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the snapshot follows the listener's attach.
+	if _, err := stream.Recv(); err != nil {
+		t.Fatal(err)
+	}
+	execute(t, conn, "call setline(1, 'three')", "call listener_flush()")
+	ev, err := stream.Recv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := ev.GetChange().GetLines(); len(lines) != 1 || lines[0] != "three" {
+		t.Fatalf("got change %v, want line three", ev)
+	}
+
+	// the edit is made and flushed while the channel is closed.
+	execute(t, conn, fmt.Sprintf("call timer_start(100, {-> [execute('VGRPCStop'), setbufline(%d, 1, 'four'), listener_flush(%d), execute('VGRPCStart')]})", bufnr, bufnr))
+	if _, err := stream.Recv(); status.Code(err) != codes.Unavailable {
+		t.Fatalf("got %v, want the watcher to fail with %v", err, codes.Unavailable)
+	}
+
+	// wait for Vim to connect again.
+	for {
+		resp, err := editor.NewEditorClient(conn).Eval(ctx, &editor.EvalRequest{Expr: "v:errmsg"})
+		if err == nil {
+			if msg := resp.Value.GetStringValue(); strings.Contains(msg, "E906") {
+				t.Fatalf("editing a watched buffer after disconnecting raised %q", msg)
+			}
+			return
+		}
+		if ctx.Err() != nil {
+			t.Fatalf("vim did not reconnect: %v", err)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
@@ -5,19 +5,82 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"runtime"
+	"regexp"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
 	"github.com/ldelossa/vim-grpc.vim/channel"
 	pb "github.com/ldelossa/vim-grpc.vim/proto/commands"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+const (
+	// DefaultCommandTimeout is used for commands registering a reply
+	// without a timeout.
+	DefaultCommandTimeout = 5 * time.Second
+	// MaxCommandTimeout bounds the time Vim waits on a reply.
+	MaxCommandTimeout = 1 * time.Minute
+)
+
+var (
+	// commandTitle matches the titles of user defined commands. The
+	// title is part of the :command Vim executes, it must not carry
+	// anything else.
+	commandTitle = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+	// completions are the -complete values a registration may use,
+	// custom completion needs a Vim function and is not supported.
+	completions = map[string]bool{
+		"arglist": true, "augroup": true, "behave": true, "breakpoint": true,
+		"buffer": true, "color": true, "command": true, "compiler": true,
+		"cscope": true, "diff_buffer": true, "dir": true, "environment": true,
+		"event": true, "expression": true, "file": true, "file_in_path": true,
+		"filetype": true, "function": true, "help": true, "highlight": true,
+		"history": true, "locale": true, "mapclear": true, "mapping": true,
+		"menu": true, "messages": true, "option": true, "packadd": true,
+		"shellcmd": true, "sign": true, "syntax": true, "syntime": true,
+		"tag": true, "tag_listfiles": true, "user": true, "var": true,
+	}
+)
+
+// commandKey identifies a registered command, the same command may be
+// registered in every session.
+type commandKey struct {
+	session string
+	id      string
+}
+
+// titleKey identifies a command title of a session.
+type titleKey struct {
+	session string
+	title   string
+}
+
 // CommandRecord is a record structure for book-keeping
 // registered extenion commands.
 type CommandRecord struct {
+	// session the command is registered in.
+	session string
+	// name the extension registered the command as.
+	command string
+	// request registered with Vim, its Command is the command's id.
+	request      *pb.RegisterCommandRequest
 	registration *pb.CommandRegistration
 	stream       pb.Commands_RegisterCommandServer
+	// serializes Sends on stream, a stream does not support
+	// concurrent Sends.
+	send *sync.Mutex
+	// time an invocation waits on the extension's reply.
+	timeout time.Duration
+	// closed when the command is unregistered.
+	unregistered chan struct{}
+}
+
+func (rec CommandRecord) key() commandKey {
+	return commandKey{session: rec.session, id: rec.request.Command}
 }
 
 // CommandsService handles extension command book-keeping (registration, listing, deleting)
@@ -26,129 +89,571 @@ type CommandsService struct {
 	*Proxy
 	pb.UnimplementedCommandsServer
 	sync.Mutex
-	cmds map[string]CommandRecord
+	// records keyed by session and command id.
+	cmds map[commandKey]CommandRecord
+	// titles of registrations waiting on Vim, see conflicts.
+	reserved map[titleKey]bool
+	// id of the next command invocation.
+	id int64
+	// replies of in-flight command invocations keyed by invocation id.
+	pending map[int64]chan *pb.CompleteCommandRequest
 }
 
 func NewCommandsService(ctx context.Context, proxy *Proxy) *CommandsService {
 	cs := &CommandsService{
-		Proxy: proxy,
-		cmds:  map[string]CommandRecord{},
-	}
-	for i := channel.CMDBoxNumOffset; i < channel.RPCBoxNumOffset; i++ {
-		go cs.monitor(ctx, i)
+		Proxy:    proxy,
+		cmds:     map[commandKey]CommandRecord{},
+		reserved: map[titleKey]bool{},
+		pending:  map[int64]chan *pb.CompleteCommandRequest{},
 	}
 	return cs
 }
 
-// monitor watches the provided mailbox number for incoming Command rpcs.
+// monitor watches the provided mailbox number of a session for incoming Command rpcs.
 //
 // when monitor encounters a Command rpc it will forward this event to the
-// extension which registered it.
-func (c *CommandsService) monitor(ctx context.Context, boxNumber uint32) {
-	var cmdEvent pb.CommandIssued
-	var ch channel.Channel
-	for ctx.Err() == nil {
-		runtime.Gosched()
-
-		ch = c.Channel()
-		if !ch.ChannelOpen() {
-			continue
-		}
-
-		d := channel.Delivery{Channel: ch, BoxNum: boxNumber}
+// extension which registered it and reply to Vim with the outcome.
+func (c *CommandsService) monitor(ctx context.Context, s *Session, boxNumber uint32) {
+	for ctx.Err() == nil && s.channel.ChannelOpen() {
+		d := channel.Delivery{Channel: s.channel, BoxNum: boxNumber}
 		env, err := d.Wait(ctx)
 		if err != nil {
 			log.Printf("CommandsService: received error waiting on mailbox %v: %v", boxNumber, err)
 			continue
 		}
 
-		err = jsonpb.Unmarshal(bytes.NewReader(env.Body), &cmdEvent)
+		cmdEvent := &pb.CommandIssued{}
+		err = jsonpb.Unmarshal(bytes.NewReader(env.Body), cmdEvent)
 		if err != nil {
 			log.Printf("CommandsService: received error serializing json to CommandIssued event %v: %v", boxNumber, err)
 			continue
 		}
 
-		err = c.doCommand(&cmdEvent)
-		if err != nil {
-			log.Printf("CommandService: failed to do command: %v", err)
-			continue
-		}
+		// the extension may take its time to reply, wait off the monitor.
+		go c.doCommand(s, env, cmdEvent)
+	}
+	log.Printf("CommandsService: session %v monitor done: %v", s.info.Id, ctx.Err())
+}
+
+// doCommand forwards a command invocation and replies to Vim with its
+// CommandResult.
+func (c *CommandsService) doCommand(s *Session, env channel.Envelope, event *pb.CommandIssued) {
+	res := c.issue(s, event)
+	if res.Error != "" {
+		log.Printf("CommandsService: command %v failed: %v", event.Command, res.Error)
+	}
+
+	m := jsonpb.Marshaler{
+		EmitDefaults: false,
+	}
+
+	var b bytes.Buffer
+	err := m.Marshal(&b, res)
+	if err != nil {
+		log.Printf("CommandsService: failed to encode command result: %v", err)
+		return
+	}
+
+	env.Body = b.Bytes()
+	err = s.channel.Reply(&env)
+	if err != nil {
+		log.Printf("CommandsService: failed to reply to command %v: %v", event.Command, err)
 	}
-	log.Printf("CommandsService: monitor ctx canceled: %v", ctx.Err())
-	return
 }
 
-func (c *CommandsService) doCommand(event *pb.CommandIssued) error {
+// issue sends a command invocation to the extension which registered it.
+//
+// If the extension registered with a reply the invocation waits on its
+// CompleteCommand call until the command's timeout.
+func (c *CommandsService) issue(s *Session, event *pb.CommandIssued) *pb.CommandResult {
 	c.Lock()
-	defer c.Unlock()
-	cmd, ok := c.cmds[event.Command]
+	cmd, ok := c.cmds[commandKey{session: s.info.Id, id: event.Command}]
 	if !ok {
-		return fmt.Errorf("command " + event.Command + "does not exist")
+		c.Unlock()
+		return &pb.CommandResult{Error: fmt.Sprintf("command %v is not registered", event.Command)}
 	}
-	if err := cmd.stream.Send(&pb.CommandEvent{Event: &pb.CommandEvent_Issued{Issued: event}}); err != nil {
-		return err
+	if cmd.stream.Context().Err() != nil {
+		c.Unlock()
+		return &pb.CommandResult{Error: fmt.Sprintf("extension %v disconnected", cmd.request.Extension)}
+	}
+	c.id++
+	event.Id = c.id
+	event.Command = cmd.command
+	var reply chan *pb.CompleteCommandRequest
+	if cmd.request.Reply {
+		reply = make(chan *pb.CompleteCommandRequest, 1)
+		c.pending[event.Id] = reply
+	}
+	c.Unlock()
+	cmd.send.Lock()
+	err := cmd.stream.Send(&pb.CommandEvent{Event: &pb.CommandEvent_Issued{Issued: event}})
+	cmd.send.Unlock()
+	if reply != nil {
+		defer func() {
+			c.Lock()
+			delete(c.pending, event.Id)
+			c.Unlock()
+		}()
+	}
+	if err != nil {
+		return &pb.CommandResult{Error: fmt.Sprintf("failed to deliver to extension %v: %v", cmd.request.Extension, err)}
+	}
+	if reply == nil {
+		return &pb.CommandResult{}
+	}
+
+	t := time.NewTimer(cmd.timeout)
+	defer t.Stop()
+	select {
+	case r := <-reply:
+		return &pb.CommandResult{Error: r.Error, Text: r.Text, Display: r.Display}
+	case <-t.C:
+		return &pb.CommandResult{Error: fmt.Sprintf("extension %v did not reply within %v", cmd.request.Extension, cmd.timeout)}
+	case <-cmd.stream.Context().Done():
+		return &pb.CommandResult{Error: fmt.Sprintf("extension %v disconnected", cmd.request.Extension)}
+	case <-cmd.unregistered:
+		return &pb.CommandResult{Error: fmt.Sprintf("command %v unregistered", event.Command)}
 	}
-	return nil
 }
 
 // RegisterCommand will attempt to register the provided command with Vim.
 // On success the Server side stream will be held open and the client will receive
 // receipt of a command invocation via calling Recv on its side of the stream.
+// If the request asks for a reply each invocation must be answered by a
+// CompleteCommand call.
 //
-// If the channel to Vim disconnects the stream will be closed.
-// If the client disconnects the stream will be closed.
-// In both occurrences the registered command is removed from Vim.
+// If the channel to Vim disconnects the command is registered again once
+// Vim reconnects, see resync.
+// If the client disconnects the stream will be closed and the registered
+// command is removed from Vim.
 func (c *CommandsService) RegisterCommand(req *pb.RegisterCommandRequest, stream pb.Commands_RegisterCommandServer) error {
 	const (
 		RPC = "RegisterCommand"
 	)
 
-	ch := c.Channel()
-	if !ch.ChannelOpen() {
-		return channel.ErrChanClosed
+	if err := validCommand(req); err != nil {
+		return err
 	}
-
-	m := jsonpb.Marshaler{
-		EmitDefaults: false,
+	timeout := time.Duration(req.TimeoutMs) * time.Millisecond
+	if timeout == 0 {
+		timeout = DefaultCommandTimeout
 	}
 
-	var b bytes.Buffer
-	err := m.Marshal(&b, req)
+	s, err := c.session(stream.Context())
 	if err != nil {
 		return err
 	}
+	ctx := withSession(stream.Context(), s.info.Id)
 
-	e := channel.Envelope{
-		RPC:  RPC,
-		Body: b.Bytes(),
+	id := req.Extension + "." + req.Command
+	titles := []string{req.Title}
+	if req.Conflict == pb.ConflictPolicy_PREFIX {
+		prefixed, err := prefixTitle(req.Extension, req.Title)
+		if err != nil {
+			return err
+		}
+		titles = append(titles, prefixed)
 	}
 
-	e, err = ch.Send(stream.Context(), &e).Wait(stream.Context())
-	if err != nil {
-		return err
+	var (
+		cmdReg   pb.CommandRegistration
+		replaced []CommandRecord
+		vimReq   *pb.RegisterCommandRequest
+		reserved []titleKey
+	)
+	// release drops the titles reserved by conflicts, c must be locked.
+	release := func() {
+		for _, k := range reserved {
+			delete(c.reserved, k)
+		}
+		reserved = nil
 	}
+	defer func() {
+		c.Lock()
+		release()
+		c.Unlock()
+	}()
+	for i, title := range titles {
+		replaced, err = c.conflicts(commandKey{session: s.info.Id, id: id}, title, req.Conflict)
+		if err != nil && i < len(titles)-1 {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		reserved = append(reserved, titleKey{session: s.info.Id, title: title})
 
-	var cmdReg pb.CommandRegistration
-	err = jsonpb.Unmarshal(bytes.NewReader(e.Body), &cmdReg)
-	if err != nil {
-		return fmt.Errorf("CommandsService: failed decoding expected CommandRegistration event: %v", err)
+		// Vim invokes the command by its id.
+		vimReq = proto.Clone(req).(*pb.RegisterCommandRequest)
+		vimReq.Command = id
+		vimReq.Title = title
+		for _, rec := range replaced {
+			if rec.request.Title == title {
+				// the command taken over is Vim's to replace.
+				vimReq.Conflict = pb.ConflictPolicy_REPLACE
+			}
+		}
+		cmdReg = pb.CommandRegistration{}
+		err = c.call(ctx, RPC, vimReq, &cmdReg)
+		if err != nil {
+			return err
+		}
+		if !cmdReg.Conflict {
+			break
+		}
 	}
 
+	if cmdReg.Conflict {
+		return status.Errorf(codes.AlreadyExists, "registration failed: %v", cmdReg.Reason)
+	}
 	if cmdReg.Registered != true {
 		return fmt.Errorf("registration failed: %v", cmdReg.Reason)
 	}
+	cmdReg.Id = id
+	cmdReg.Title = vimReq.Title
 
-	c.Lock()
-	c.cmds[req.Command] = CommandRecord{
+	rec := CommandRecord{
+		session:      s.info.Id,
+		command:      req.Command,
+		request:      vimReq,
 		registration: &cmdReg,
 		stream:       stream,
+		send:         &sync.Mutex{},
+		timeout:      timeout,
+		unregistered: make(chan struct{}),
+	}
+	// the registration is the first event on the stream, invocations
+	// wait on it.
+	rec.send.Lock()
+	c.Lock()
+	// commands of the id registered under another title, which Vim
+	// did not replace.
+	var retitled []CommandRecord
+	for _, old := range replaced {
+		if cur, ok := c.cmds[old.key()]; ok && cur.unregistered == old.unregistered {
+			delete(c.cmds, old.key())
+			close(old.unregistered)
+			if old.request.Title != vimReq.Title {
+				retitled = append(retitled, old)
+			}
+		}
 	}
+	c.cmds[rec.key()] = rec
+	release()
 	c.Unlock()
+	for _, old := range retitled {
+		c.unregister(old)
+	}
 
 	stream.Send(&pb.CommandEvent{
 		Event: &pb.CommandEvent_Registration{Registration: &cmdReg},
 	})
+	rec.send.Unlock()
+
+	select {
+	case <-stream.Context().Done():
+		c.remove(rec)
+		return stream.Context().Err()
+	case <-rec.unregistered:
+		return status.Errorf(codes.Canceled, "command %v unregistered", id)
+	}
+}
+
+// conflicts returns the records of the session a registration under title
+// takes over.
+//
+// Records of extensions which went away are always taken over, live ones
+// only with the REPLACE policy.
+//
+// On success the title is reserved until the caller releases it, once
+// its record is inserted or the registration failed. A title reserved
+// by another registration is a conflict under any policy.
+func (c *CommandsService) conflicts(key commandKey, title string, policy pb.ConflictPolicy) ([]CommandRecord, error) {
+	var recs []CommandRecord
+	c.Lock()
+	defer c.Unlock()
+	tk := titleKey{session: key.session, title: title}
+	if c.reserved[tk] {
+		return nil, status.Errorf(codes.Aborted, "command %v conflicts with a registration of %v in progress", key.id, title)
+	}
+	for k, rec := range c.cmds {
+		if k.session != key.session || (k.id != key.id && rec.request.Title != title) {
+			continue
+		}
+		if rec.stream.Context().Err() == nil && policy != pb.ConflictPolicy_REPLACE {
+			return nil, status.Errorf(codes.AlreadyExists, "command %v conflicts with %v registered as %v", key.id, k.id, rec.request.Title)
+		}
+		recs = append(recs, rec)
+	}
+	c.reserved[tk] = true
+	return recs, nil
+}
+
+// prefixTitle prefixes a title with the capitalized alphanumerics of the
+// extension name.
+func prefixTitle(extension string, title string) (string, error) {
+	var prefix []byte
+	for i := 0; i < len(extension); i++ {
+		b := extension[i]
+		if (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9') {
+			prefix = append(prefix, b)
+		}
+	}
+	if len(prefix) == 0 || prefix[0] >= '0' && prefix[0] <= '9' {
+		return "", status.Errorf(codes.InvalidArgument, "extension %q cannot prefix a title", extension)
+	}
+	if prefix[0] >= 'a' && prefix[0] <= 'z' {
+		prefix[0] -= 'a' - 'A'
+	}
+	return string(prefix) + title, nil
+}
+
+// remove deletes a record whose extension went away and asks Vim to
+// delete its command.
+func (c *CommandsService) remove(rec CommandRecord) {
+	c.Lock()
+	// the command may have been registered again in the meantime.
+	cur, ok := c.cmds[rec.key()]
+	if !ok || cur.unregistered != rec.unregistered {
+		c.Unlock()
+		return
+	}
+	delete(c.cmds, rec.key())
+	c.Unlock()
+	c.unregister(rec)
+}
+
+// unregister asks Vim to delete the command of a record.
+func (c *CommandsService) unregister(rec CommandRecord) {
+	const (
+		RPC = "UnregisterCommand"
+	)
+
+	ctx, cancel := context.WithTimeout(withSession(context.Background(), rec.session), 5*time.Second)
+	defer cancel()
+	err := c.call(ctx, RPC, rec.request, &pb.UnregisterCommandResponse{})
+	if err != nil {
+		log.Printf("CommandsService: failed to remove command %v: %v", rec.request.Command, err)
+	}
+}
+
+// resync registers the recorded commands of the session the ctx routes
+// to with its newly connected Vim.
+//
+// Vim deletes its registered commands when the channel closes. Records of
+// extensions which went away are pruned, as are commands Vim no longer
+// accepts, in which case their streams are closed.
+func (c *CommandsService) resync(ctx context.Context) {
+	const (
+		RPC = "RegisterCommand"
+	)
+
+	s, err := c.session(ctx)
+	if err != nil {
+		log.Printf("CommandsService: failed to register commands again: %v", err)
+		return
+	}
+
+	var recs []CommandRecord
+	c.Lock()
+	for key, rec := range c.cmds {
+		if key.session != s.info.Id {
+			continue
+		}
+		if rec.stream.Context().Err() != nil {
+			delete(c.cmds, key)
+			continue
+		}
+		recs = append(recs, rec)
+	}
+	c.Unlock()
+
+	for _, rec := range recs {
+		tctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		var cmdReg pb.CommandRegistration
+		err := c.call(tctx, RPC, rec.request, &cmdReg)
+		cancel()
+		if err == nil && cmdReg.Registered {
+			continue
+		}
+		if err == nil {
+			err = fmt.Errorf("registration failed: %v", cmdReg.Reason)
+		}
+		log.Printf("CommandsService: failed to register command %v again: %v", rec.request.Command, err)
+		c.Lock()
+		if cur, ok := c.cmds[rec.key()]; ok && cur.unregistered == rec.unregistered {
+			delete(c.cmds, rec.key())
+			close(rec.unregistered)
+		}
+		c.Unlock()
+	}
+}
+
+// ListCommands returns the commands registered in a session, optionally
+// filtered by extension.
+func (c *CommandsService) ListCommands(ctx context.Context, req *pb.ListCommandsRequest) (*pb.ListCommandsResponse, error) {
+	s, err := c.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListCommandsResponse{}
+	c.Lock()
+	for key, rec := range c.cmds {
+		if key.session != s.info.Id {
+			continue
+		}
+		if req.Extension != "" && req.Extension != rec.request.Extension {
+			continue
+		}
+		resp.Commands = append(resp.Commands, &pb.CommandInfo{
+			Extension: rec.request.Extension,
+			Command:   rec.command,
+			Title:     rec.request.Title,
+			Connected: rec.stream.Context().Err() == nil,
+			Id:        rec.request.Command,
+		})
+	}
+	c.Unlock()
+	sort.Slice(resp.Commands, func(i, j int) bool {
+		return resp.Commands[i].Id < resp.Commands[j].Id
+	})
+	return resp, nil
+}
+
+// UnregisterCommand removes a registered command from Vim.
+//
+// The registering extension's stream is closed with a Canceled error.
+func (c *CommandsService) UnregisterCommand(ctx context.Context, req *pb.UnregisterCommandRequest) (*pb.UnregisterCommandResponse, error) {
+	const (
+		RPC = "UnregisterCommand"
+	)
+
+	s, err := c.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = withSession(ctx, s.info.Id)
 
-	<-stream.Context().Done()
-	return stream.Context().Err()
+	c.Lock()
+	rec, ok := c.cmds[commandKey{session: s.info.Id, id: req.Command}]
+	c.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "command %v is not registered", req.Command)
+	}
+
+	err = c.call(ctx, RPC, rec.request, &pb.UnregisterCommandResponse{})
+	if err != nil {
+		return nil, err
+	}
+
+	c.Lock()
+	// the command may have been registered again in the meantime.
+	if cur, ok := c.cmds[rec.key()]; ok && cur.unregistered == rec.unregistered {
+		delete(c.cmds, rec.key())
+		close(rec.unregistered)
+	}
+	c.Unlock()
+	return &pb.UnregisterCommandResponse{}, nil
+}
+
+// ExecCommand executes a registered command in Vim as if the user typed it.
+//
+// Errors raised while executing the command are returned as a status
+// error with a VimError detail.
+func (c *CommandsService) ExecCommand(ctx context.Context, req *pb.ExecCommandRequest) (*pb.ExecCommandResponse, error) {
+	const (
+		RPC = "ExecCommand"
+	)
+
+	s, err := c.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = withSession(ctx, s.info.Id)
+
+	c.Lock()
+	rec, ok := c.cmds[commandKey{session: s.info.Id, id: req.Command}]
+	c.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "command %v is not registered", req.Command)
+	}
+	if req.Bang && !rec.request.Bang {
+		return nil, status.Errorf(codes.InvalidArgument, "command %v does not accept a !", req.Command)
+	}
+
+	res := &pb.ExecCommandResult{}
+	err = c.call(ctx, RPC, &pb.ExecCommandRequest{
+		Command: rec.request.Title,
+		Range:   req.Range,
+		Bang:    req.Bang,
+		Args:    req.Args,
+	}, res)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExecCommandResponse{}, nil
+}
+
+// CompleteCommand answers a command invocation with the extension's
+// reply, which Vim shows to the user.
+//
+// A NotFound error is returned if the invocation already timed out.
+func (c *CommandsService) CompleteCommand(ctx context.Context, req *pb.CompleteCommandRequest) (*pb.CompleteCommandResponse, error) {
+	c.Lock()
+	reply, ok := c.pending[req.Id]
+	c.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "command invocation %v not pending, it may have timed out", req.Id)
+	}
+
+	select {
+	case reply <- req:
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "command invocation %v already completed", req.Id)
+	}
+	return &pb.CompleteCommandResponse{}, nil
+}
+
+// validCommand validates the :command attributes of a registration.
+func validCommand(req *pb.RegisterCommandRequest) error {
+	if req.Command == "" || req.Title == "" {
+		return status.Errorf(codes.InvalidArgument, "command and title are required")
+	}
+	if !commandTitle.MatchString(req.Title) {
+		return status.Errorf(codes.InvalidArgument, "title must be an uppercase letter followed by letters and digits: %q", req.Title)
+	}
+	if req.Complete != "" && !completions[req.Complete] {
+		return status.Errorf(codes.InvalidArgument, "invalid complete: %q", req.Complete)
+	}
+	switch req.Nargs {
+	case "", "0", "1", "*", "?", "+":
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid nargs: %q", req.Nargs)
+	}
+	if req.Range != "" && req.Count != "" {
+		return status.Errorf(codes.InvalidArgument, "range and count are mutually exclusive")
+	}
+	if req.Range != "" && req.Range != "." && req.Range != "%" && !isDigits(req.Range) {
+		return status.Errorf(codes.InvalidArgument, "invalid range: %q", req.Range)
+	}
+	if req.Count != "" && !isDigits(req.Count) {
+		return status.Errorf(codes.InvalidArgument, "invalid count: %q", req.Count)
+	}
+	if req.Bufnr < 0 || (req.Bufnr != 0 && !req.Buffer) {
+		return status.Errorf(codes.InvalidArgument, "bufnr requires a buffer local command")
+	}
+	if timeout := time.Duration(req.TimeoutMs) * time.Millisecond; timeout < 0 || timeout > MaxCommandTimeout {
+		return status.Errorf(codes.InvalidArgument, "timeout must be between 0 and %v", MaxCommandTimeout)
+	}
+	return nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
 }
@@ -0,0 +1,168 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/ldelossa/vim-grpc.vim/proto/commands"
+)
+
+func TestValidCommand(t *testing.T) {
+	tt := []struct {
+		req   *pb.RegisterCommandRequest
+		valid bool
+	}{
+		{&pb.RegisterCommandRequest{Command: "c", Title: "Hello"}, true},
+		{&pb.RegisterCommandRequest{Command: "c", Title: "Hello2", Complete: "file"}, true},
+		{&pb.RegisterCommandRequest{Command: "c", Title: "hello"}, false},
+		{&pb.RegisterCommandRequest{Command: "c", Title: "Foo call system('ls')"}, false},
+		{&pb.RegisterCommandRequest{Command: "c", Title: "Foo|echo"}, false},
+		{&pb.RegisterCommandRequest{Command: "c", Title: "Hello", Complete: "customlist,Foo"}, false},
+		{&pb.RegisterCommandRequest{Command: "c", Title: "Hello", Complete: "file call Foo()"}, false},
+	}
+	for _, tc := range tt {
+		err := validCommand(tc.req)
+		if (err == nil) != tc.valid {
+			t.Errorf("title %q complete %q: got %v, want valid %v", tc.req.Title, tc.req.Complete, err, tc.valid)
+		}
+	}
+}
+
+// TestCommandResults invokes two commands before either replies, each
+// result must be shown under its own title.
+func TestCommandResults(t *testing.T) {
+	conn := startVim(t)
+	client := pb.NewCommandsClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	titles := []string{"Hello", "World"}
+	issued := make(chan *pb.CommandIssued, len(titles))
+	for _, title := range titles {
+		stream, err := client.RegisterCommand(ctx, &pb.RegisterCommandRequest{
+			Extension: "test",
+			Command:   strings.ToLower(title),
+			Title:     title,
+			Reply:     true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ev, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ev.GetRegistration().GetRegistered() {
+			t.Fatalf("%v not registered: %v", title, ev.GetRegistration().GetReason())
+		}
+		go func() {
+			for {
+				ev, err := stream.Recv()
+				if err != nil {
+					return
+				}
+				issued <- ev.GetIssued()
+			}
+		}()
+	}
+
+	execute(t, conn, "Hello", "World")
+	for range titles {
+		ev := <-issued
+		_, err := client.CompleteCommand(ctx, &pb.CompleteCommandRequest{Id: ev.Id, Error: "failed " + ev.Command})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for {
+		messages := eval(t, conn, "execute('messages')").(string)
+		if strings.Contains(messages, "Hello: failed hello") && strings.Contains(messages, "World: failed world") {
+			return
+		}
+		if ctx.Err() != nil {
+			t.Fatalf("got messages %q, want each result under its title", messages)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+// TestRegisterCommandConcurrent registers one title under several ids at
+// once, a single registration may win.
+func TestRegisterCommandConcurrent(t *testing.T) {
+	conn := startVim(t)
+	client := pb.NewCommandsClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	const n = 8
+	registered := make(chan bool, n)
+	for i := 0; i < n; i++ {
+		go func(i int) {
+			stream, err := client.RegisterCommand(ctx, &pb.RegisterCommandRequest{
+				Extension: "test",
+				Command:   fmt.Sprintf("cmd%d", i),
+				Title:     "Same",
+			})
+			if err == nil {
+				_, err = stream.Recv()
+			}
+			registered <- err == nil
+		}(i)
+	}
+	var won int
+	for i := 0; i < n; i++ {
+		if <-registered {
+			won++
+		}
+	}
+	if won != 1 {
+		t.Fatalf("%d registrations of the same title succeeded, want 1", won)
+	}
+}
+
+// TestRegisterCommandRetitled registers a command again under another
+// title, the command of the old title must be deleted.
+func TestRegisterCommandRetitled(t *testing.T) {
+	conn := startVim(t)
+	client := pb.NewCommandsClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	for _, title := range []string{"Old", "New"} {
+		stream, err := client.RegisterCommand(ctx, &pb.RegisterCommandRequest{
+			Extension: "test",
+			Command:   "ext.cmd",
+			Title:     title,
+			Conflict:  pb.ConflictPolicy_REPLACE,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ev, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ev.GetRegistration().GetRegistered() {
+			t.Fatalf("%v not registered: %v", title, ev.GetRegistration().GetReason())
+		}
+	}
+
+	if exists := eval(t, conn, "exists(':New')"); exists != float64(2) {
+		t.Fatalf("exists(':New') = %v, want 2", exists)
+	}
+	// the old title is deleted after the new one is registered.
+	for {
+		exists := eval(t, conn, "exists(':Old')")
+		if exists == float64(0) {
+			return
+		}
+		if ctx.Err() != nil {
+			t.Fatalf("exists(':Old') = %v, want 0", exists)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
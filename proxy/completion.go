@@ -0,0 +1,312 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/ldelossa/vim-grpc.vim/channel"
+	pb "github.com/ldelossa/vim-grpc.vim/proto/completion"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// filetypeName matches the characters Vim allows in a 'filetype', the
+// filetypes are part of the autocommands Vim defines.
+var filetypeName = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+const (
+	// DefaultCompletionTimeout is used for providers registering
+	// without a timeout.
+	DefaultCompletionTimeout = 500 * time.Millisecond
+	// MaxCompletionTimeout bounds the time insert mode waits on
+	// a provider.
+	MaxCompletionTimeout = 5 * time.Second
+)
+
+// CompletionProvider is a record structure for book-keeping
+// registered completion sources.
+type CompletionProvider struct {
+	// session the provider completes in.
+	session      string
+	registration *pb.RegisterCompletionRequest
+	timeout      time.Duration
+	// requests forwarded to the provider's stream.
+	requests chan *pb.CompletionRequest
+}
+
+// CompletionService handles completion source registration and monitors
+// the channel's completion mailboxes for Vim's completion requests.
+//
+// A completion request is forwarded to every provider registered for the
+// buffer's filetype and answered with the candidates they provide before
+// the timeout expires.
+type CompletionService struct {
+	*Proxy
+	pb.UnimplementedCompletionServer
+	sync.Mutex
+	providers map[*CompletionProvider]struct{}
+	// id of the next completion request.
+	id int64
+	// candidates of in-flight completion requests keyed by request id.
+	pending map[int64]chan []*pb.CompletionItem
+}
+
+func NewCompletionService(ctx context.Context, proxy *Proxy) *CompletionService {
+	cs := &CompletionService{
+		Proxy:     proxy,
+		providers: map[*CompletionProvider]struct{}{},
+		pending:   map[int64]chan []*pb.CompletionItem{},
+	}
+	return cs
+}
+
+// monitor watches the provided mailbox number of a session for incoming CompletionRequested rpcs.
+//
+// when monitor encounters a CompletionRequested rpc it will gather candidates
+// from the session's providers and reply to Vim.
+func (c *CompletionService) monitor(ctx context.Context, s *Session, boxNumber uint32) {
+	for ctx.Err() == nil && s.channel.ChannelOpen() {
+		d := channel.Delivery{Channel: s.channel, BoxNum: boxNumber}
+		env, err := d.Wait(ctx)
+		if err != nil {
+			log.Printf("CompletionService: received error waiting on mailbox %v: %v", boxNumber, err)
+			continue
+		}
+
+		req := &pb.CompletionRequest{}
+		err = jsonpb.Unmarshal(bytes.NewReader(env.Body), req)
+		if err != nil {
+			log.Printf("CompletionService: received error serializing json to CompletionRequest %v: %v", boxNumber, err)
+			continue
+		}
+
+		// Vim blocks until replied to, gather candidates off the monitor.
+		go c.complete(s, env, req)
+	}
+	log.Printf("CompletionService: session %v monitor done: %v", s.info.Id, ctx.Err())
+}
+
+// complete forwards a completion request to the providers of the buffer's
+// filetype and replies to Vim with their candidates.
+//
+// Providers which do not answer before their timeout are ignored.
+func (c *CompletionService) complete(s *Session, env channel.Envelope, req *pb.CompletionRequest) {
+	var providers []*CompletionProvider
+	timeout := time.Duration(0)
+	c.Lock()
+	c.id++
+	req.Id = c.id
+	for p := range c.providers {
+		if p.session != s.info.Id {
+			continue
+		}
+		for _, ft := range p.registration.Filetypes {
+			if ft == req.Filetype {
+				providers = append(providers, p)
+				if p.timeout > timeout {
+					timeout = p.timeout
+				}
+				break
+			}
+		}
+	}
+	candidates := make(chan []*pb.CompletionItem, len(providers))
+	c.pending[req.Id] = candidates
+	c.Unlock()
+	defer func() {
+		c.Lock()
+		delete(c.pending, req.Id)
+		c.Unlock()
+	}()
+
+	for _, p := range providers {
+		select {
+		case p.requests <- req:
+		default:
+			log.Printf("CompletionService: provider %v is busy, skipping", p.registration.Extension)
+		}
+	}
+
+	reply := &pb.CompletionReply{}
+	t := time.NewTimer(timeout)
+	defer t.Stop()
+gather:
+	for range providers {
+		select {
+		case items := <-candidates:
+			reply.Items = append(reply.Items, items...)
+		case <-t.C:
+			log.Printf("CompletionService: completion request %v timed out", req.Id)
+			break gather
+		}
+	}
+
+	m := jsonpb.Marshaler{
+		EmitDefaults: false,
+	}
+
+	var b bytes.Buffer
+	err := m.Marshal(&b, reply)
+	if err != nil {
+		log.Printf("CompletionService: failed to encode completion reply: %v", err)
+		return
+	}
+
+	env.Body = b.Bytes()
+	err = s.channel.Reply(&env)
+	if err != nil {
+		log.Printf("CompletionService: failed to reply to completion request %v: %v", req.Id, err)
+	}
+}
+
+// RegisterCompletion will attempt to register the extension as a completion
+// source for the provided filetypes.
+// On success the Server side stream will be held open and the client will receive
+// CompletionRequests by calling Recv on its side of the stream. Each request must be
+// answered by a ProvideCompletions call.
+//
+// If the channel to Vim disconnects the stream will be closed.
+// If the client disconnects the stream will be closed and the completion source
+// is removed.
+func (c *CompletionService) RegisterCompletion(req *pb.RegisterCompletionRequest, stream pb.Completion_RegisterCompletionServer) error {
+	const (
+		RPC           = "RegisterCompletion"
+		UnregisterRPC = "UnregisterCompletion"
+		// pending requests before a provider is considered busy.
+		backlog = 16
+	)
+
+	if req.Extension == "" {
+		return status.Errorf(codes.InvalidArgument, "extension is required")
+	}
+	if len(req.Filetypes) == 0 {
+		return status.Errorf(codes.InvalidArgument, "at least one filetype is required")
+	}
+	for _, ft := range req.Filetypes {
+		if !filetypeName.MatchString(ft) {
+			return status.Errorf(codes.InvalidArgument, "invalid filetype: %q", ft)
+		}
+	}
+	timeout := time.Duration(req.TimeoutMs) * time.Millisecond
+	switch {
+	case timeout < 0 || timeout > MaxCompletionTimeout:
+		return status.Errorf(codes.InvalidArgument, "timeout must be between 0 and %v", MaxCompletionTimeout)
+	case timeout == 0:
+		timeout = DefaultCompletionTimeout
+		req.TimeoutMs = timeout.Milliseconds()
+	}
+
+	s, err := c.session(stream.Context())
+	if err != nil {
+		return err
+	}
+	ch := s.channel
+	if !ch.ChannelOpen() {
+		return channel.ErrChanClosed
+	}
+	ctx := withSession(stream.Context(), s.info.Id)
+
+	var reg pb.CompletionRegistration
+	err = c.call(ctx, RPC, req, &reg)
+	if err != nil {
+		return err
+	}
+	if !reg.Registered {
+		return fmt.Errorf("registration failed: %v", reg.Reason)
+	}
+
+	p := &CompletionProvider{
+		session:      s.info.Id,
+		registration: req,
+		timeout:      timeout,
+		requests:     make(chan *pb.CompletionRequest, backlog),
+	}
+	c.Lock()
+	c.providers[p] = struct{}{}
+	c.Unlock()
+	defer c.unregister(p, UnregisterRPC)
+
+	err = stream.Send(&pb.CompletionEvent{
+		Event: &pb.CompletionEvent_Registration{Registration: &reg},
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-ch.Done():
+			return status.Errorf(codes.Unavailable, "channel closed")
+		case r := <-p.requests:
+			err = stream.Send(&pb.CompletionEvent{
+				Event: &pb.CompletionEvent_Request{Request: r},
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// unregister removes a provider and asks Vim to stop completing the
+// filetypes no other provider is registered for.
+func (c *CompletionService) unregister(p *CompletionProvider, rpc string) {
+	c.Lock()
+	delete(c.providers, p)
+	orphaned := &pb.RegisterCompletionRequest{Extension: p.registration.Extension}
+	for _, ft := range p.registration.Filetypes {
+		found := false
+		for other := range c.providers {
+			if other.session != p.session {
+				continue
+			}
+			for _, oft := range other.registration.Filetypes {
+				if oft == ft {
+					found = true
+				}
+			}
+		}
+		if !found {
+			orphaned.Filetypes = append(orphaned.Filetypes, ft)
+		}
+	}
+	c.Unlock()
+
+	if len(orphaned.Filetypes) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(withSession(context.Background(), p.session), 5*time.Second)
+	defer cancel()
+	err := c.call(ctx, rpc, orphaned, &pb.CompletionRegistration{})
+	if err != nil {
+		log.Printf("CompletionService: failed to unregister filetypes %v: %v", orphaned.Filetypes, err)
+	}
+}
+
+// ProvideCompletions answers a CompletionRequest with the extension's
+// candidates.
+//
+// A NotFound error is returned if the request already timed out.
+func (c *CompletionService) ProvideCompletions(ctx context.Context, req *pb.ProvideCompletionsRequest) (*pb.ProvideCompletionsResponse, error) {
+	c.Lock()
+	candidates, ok := c.pending[req.Id]
+	c.Unlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "completion request %v not pending, it may have timed out", req.Id)
+	}
+
+	select {
+	case candidates <- req.Items:
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "completion request %v already answered", req.Id)
+	}
+	return &pb.ProvideCompletionsResponse{}, nil
+}
@@ -0,0 +1,82 @@
+package proxy
+
+import (
+	"context"
+
+	pb "github.com/ldelossa/vim-grpc.vim/proto/diagnostics"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DiagnosticsService provides RPCs publishing diagnostics, which Vim
+// renders with signs and text properties.
+//
+// Each source owns one set of diagnostics per buffer, allowing the
+// diagnostics of several extensions to coexist.
+type DiagnosticsService struct {
+	*Proxy
+	pb.UnimplementedDiagnosticsServer
+}
+
+func NewDiagnosticsService(ctx context.Context, proxy *Proxy) *DiagnosticsService {
+	return &DiagnosticsService{
+		Proxy: proxy,
+	}
+}
+
+// PublishDiagnostics atomically replaces the source's diagnostics
+// for a buffer.
+func (d *DiagnosticsService) PublishDiagnostics(ctx context.Context, req *pb.PublishDiagnosticsRequest) (*pb.PublishDiagnosticsResponse, error) {
+	const (
+		RPC = "PublishDiagnostics"
+	)
+
+	if req.Source == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "source is required")
+	}
+	if req.Bufnr <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid buffer number: %v", req.Bufnr)
+	}
+	for i, diag := range req.Diagnostics {
+		r := diag.Range
+		switch {
+		case r == nil:
+			return nil, status.Errorf(codes.InvalidArgument, "diagnostic %d: range is required", i)
+		case r.StartLnum <= 0 || r.StartCol < 0:
+			return nil, status.Errorf(codes.InvalidArgument, "diagnostic %d: invalid start %v:%v", i, r.StartLnum, r.StartCol)
+		case r.EndLnum != 0 && r.EndLnum < r.StartLnum:
+			return nil, status.Errorf(codes.InvalidArgument, "diagnostic %d: end line %v before start line %v", i, r.EndLnum, r.StartLnum)
+		case r.EndCol < 0:
+			return nil, status.Errorf(codes.InvalidArgument, "diagnostic %d: invalid end column %v", i, r.EndCol)
+		}
+	}
+
+	res := &pb.PublishResult{}
+	err := d.call(ctx, RPC, req, res)
+	if err != nil {
+		return nil, err
+	}
+	if !res.Found {
+		return nil, status.Errorf(codes.NotFound, "buffer %v not found", req.Bufnr)
+	}
+	return &pb.PublishDiagnosticsResponse{}, nil
+}
+
+// ListDiagnostics returns the published diagnostic sets, optionally
+// filtered by buffer and source.
+func (d *DiagnosticsService) ListDiagnostics(ctx context.Context, req *pb.ListDiagnosticsRequest) (*pb.ListDiagnosticsResponse, error) {
+	const (
+		RPC = "ListDiagnostics"
+	)
+
+	if req.Bufnr < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid buffer number: %v", req.Bufnr)
+	}
+
+	resp := &pb.ListDiagnosticsResponse{}
+	err := d.call(ctx, RPC, req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
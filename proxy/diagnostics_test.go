@@ -0,0 +1,60 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/ldelossa/vim-grpc.vim/proto/diagnostics"
+)
+
+// TestPublishDiagnosticsReplace replaces a source's diagnostics, the
+// previous signs and text properties must all be removed.
+func TestPublishDiagnosticsReplace(t *testing.T) {
+	conn := startVim(t)
+	client := pb.NewDiagnosticsClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	execute(t, conn, "enew", "call setline(1, ['one', 'two', 'three'])")
+	bufnr := int64(eval(t, conn, "bufnr()").(float64))
+	publish := func(diags ...*pb.Diagnostic) {
+		t.Helper()
+		_, err := client.PublishDiagnostics(ctx, &pb.PublishDiagnosticsRequest{
+			Source:      "test",
+			Bufnr:       bufnr,
+			Diagnostics: diags,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	counts := func() (signs, props float64) {
+		t.Helper()
+		signs = eval(t, conn, "len(sign_getplaced(bufnr(), {'group': '*'})[0].signs)").(float64)
+		props = eval(t, conn, "len(prop_list(1, {'end_lnum': -1}))").(float64)
+		return signs, props
+	}
+
+	publish(
+		&pb.Diagnostic{Range: &pb.Range{StartLnum: 1, StartCol: 1}},
+		&pb.Diagnostic{Severity: pb.Severity_WARNING, Range: &pb.Range{StartLnum: 2, StartCol: 2, EndCol: 3}},
+	)
+	if signs, props := counts(); signs != 2 || props != 2 {
+		t.Fatalf("got %v signs and %v props, want 2 and 2", signs, props)
+	}
+
+	// a column past the end of its line is signed but not highlighted.
+	publish(&pb.Diagnostic{Range: &pb.Range{StartLnum: 3, StartCol: 20}})
+	if signs, props := counts(); signs != 1 || props != 0 {
+		t.Fatalf("got %v signs and %v props, want 1 and 0", signs, props)
+	}
+
+	resp, err := client.ListDiagnostics(ctx, &pb.ListDiagnosticsRequest{Bufnr: bufnr})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Sets) != 1 || len(resp.Sets[0].Diagnostics) != 1 {
+		t.Fatalf("got sets %v, want the replacement only", resp.Sets)
+	}
+}
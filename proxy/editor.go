@@ -0,0 +1,65 @@
+package proxy
+
+import (
+	"context"
+
+	pb "github.com/ldelossa/vim-grpc.vim/proto/editor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EditorService provides RPCs executing arbitrary Ex commands
+// and expressions in Vim.
+type EditorService struct {
+	*Proxy
+	pb.UnimplementedEditorServer
+}
+
+func NewEditorService(ctx context.Context, proxy *Proxy) *EditorService {
+	return &EditorService{
+		Proxy: proxy,
+	}
+}
+
+// Execute runs the provided Ex commands in order and returns
+// their captured output.
+//
+// Execution stops at the first command raising an error, which is
+// returned as a status error with a VimError detail.
+func (e *EditorService) Execute(ctx context.Context, req *pb.ExecuteRequest) (*pb.ExecuteResponse, error) {
+	const (
+		RPC = "Execute"
+	)
+
+	if len(req.Commands) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one command is required")
+	}
+
+	res := &pb.ExecuteResult{}
+	err := e.call(ctx, RPC, req, res)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExecuteResponse{Output: res.Output}, nil
+}
+
+// Eval evaluates a Vim expression and returns its value.
+//
+// An expression raising an error is returned as a status error
+// with a VimError detail.
+func (e *EditorService) Eval(ctx context.Context, req *pb.EvalRequest) (*pb.EvalResponse, error) {
+	const (
+		RPC = "Eval"
+	)
+
+	if req.Expr == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "expression must not be empty")
+	}
+
+	res := &pb.EvalResult{}
+	err := e.call(ctx, RPC, req, res)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EvalResponse{Value: res.Value, Type: res.Type}, nil
+}
@@ -0,0 +1,86 @@
+package proxy
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	editor "github.com/ldelossa/vim-grpc.vim/proto/editor"
+	windows "github.com/ldelossa/vim-grpc.vim/proto/windows"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestVimErrors fails rpcs with Vim errors, each must fail with its
+// status code and the exception as a VimError detail.
+func TestVimErrors(t *testing.T) {
+	conn := startVim(t)
+	client := editor.NewEditorClient(conn)
+	ctx := context.Background()
+
+	tt := []struct {
+		name string
+		call func() error
+		code codes.Code
+		vim  string
+	}{
+		{
+			name: "Execute",
+			call: func() error {
+				_, err := client.Execute(ctx, &editor.ExecuteRequest{Commands: []string{"echo 1", "NoSuchCommand"}})
+				return err
+			},
+			code: codes.InvalidArgument,
+			vim:  "E492",
+		},
+		{
+			name: "Eval",
+			call: func() error {
+				_, err := client.Eval(ctx, &editor.EvalRequest{Expr: "no_such_variable"})
+				return err
+			},
+			code: codes.InvalidArgument,
+			vim:  "E121",
+		},
+		{
+			name: "ExecuteNotFound",
+			call: func() error {
+				_, err := client.Execute(ctx, &editor.ExecuteRequest{Commands: []string{"buffer 9999"}})
+				return err
+			},
+			code: codes.NotFound,
+			vim:  "E86",
+		},
+		{
+			name: "CloseWindow",
+			call: func() error {
+				winid := int64(eval(t, conn, "win_getid()").(float64))
+				_, err := windows.NewWindowsClient(conn).CloseWindow(ctx, &windows.CloseWindowRequest{Winid: winid})
+				return err
+			},
+			code: codes.FailedPrecondition,
+			vim:  "E444",
+		},
+	}
+	for _, tc := range tt {
+		st := status.Convert(tc.call())
+		if st.Code() != tc.code {
+			t.Errorf("%v: got %v, want code %v", tc.name, st.Err(), tc.code)
+			continue
+		}
+		var found bool
+		for _, d := range st.Details() {
+			if verr, ok := d.(*editor.VimError); ok && verr.Code == tc.vim {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("%v: got details %v, want a %v VimError", tc.name, st.Details(), tc.vim)
+		}
+	}
+
+	_, err := client.Execute(ctx, &editor.ExecuteRequest{Commands: []string{"echo 1", "NoSuchCommand"}})
+	if msg := status.Convert(err).Message(); !strings.HasPrefix(msg, "command 2 ('NoSuchCommand') failed: ") {
+		t.Errorf("got message %q, want the failed command", msg)
+	}
+}
@@ -28,7 +28,10 @@ func (env *EnvironmentService) GetEnv(ctx context.Context, req *env.GetEnvReques
 		RPC = "GetEnv"
 	)
 
-	ch := env.Channel()
+	ch, err := env.Channel(ctx)
+	if err != nil {
+		return nil, err
+	}
 	if !ch.ChannelOpen() {
 		return nil, channel.ErrChanClosed
 	}
@@ -38,7 +41,7 @@ func (env *EnvironmentService) GetEnv(ctx context.Context, req *env.GetEnvReques
 	}
 
 	var b bytes.Buffer
-	err := m.Marshal(&b, req)
+	err = m.Marshal(&b, req)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,312 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/ldelossa/vim-grpc.vim/channel"
+	pb "github.com/ldelossa/vim-grpc.vim/proto/events"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// subscriber is a Subscribe stream awaiting autocommand events.
+type subscriber struct {
+	// session the subscriber receives the events of.
+	session string
+	req     *pb.SubscribeRequest
+	events  chan *pb.Event
+	// closed when the subscriber falls behind and events
+	// could no longer be delivered.
+	overflow chan struct{}
+	once     sync.Once
+}
+
+// match reports whether the event passes the subscriber's filters.
+func (s *subscriber) match(ev *pb.Event) bool {
+	found := false
+	for _, name := range s.req.Events {
+		if strings.EqualFold(name, ev.Event) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return false
+	}
+	if s.req.Bufnr != 0 && s.req.Bufnr != ev.Bufnr {
+		return false
+	}
+	if len(s.req.Filetypes) > 0 {
+		found = false
+		for _, ft := range s.req.Filetypes {
+			if ft == ev.Filetype {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if s.req.Pattern != "" {
+		// like Vim's autocommand patterns, a pattern without a
+		// path separator is matched against the file's tail.
+		file := ev.File
+		if !strings.Contains(s.req.Pattern, "/") {
+			file = path.Base(file)
+		}
+		if ok, _ := path.Match(s.req.Pattern, file); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// deliver hands an event to the subscriber without blocking.
+func (s *subscriber) deliver(ev *pb.Event) {
+	select {
+	case s.events <- ev:
+	default:
+		s.once.Do(func() { close(s.overflow) })
+	}
+}
+
+// EventsService streams Vim autocommand events to subscribed extensions.
+//
+// Each Vim installs a single autocommand per event name no matter how many
+// extensions subscribe to it. EventsService monitors the channel's event
+// mailboxes and fans each event out to the matching subscribers.
+type EventsService struct {
+	*Proxy
+	pb.UnimplementedEventsServer
+	sync.Mutex
+	subs map[*subscriber]struct{}
+	// serializes autocommand installation and removal.
+	autocmdsMu sync.Mutex
+	// subscriber count per session and lower cased event name.
+	autocmds map[string]map[string]int
+}
+
+func NewEventsService(ctx context.Context, proxy *Proxy) *EventsService {
+	es := &EventsService{
+		Proxy:    proxy,
+		subs:     map[*subscriber]struct{}{},
+		autocmds: map[string]map[string]int{},
+	}
+	return es
+}
+
+// monitor watches the provided mailbox number of a session for incoming AutocmdFired rpcs.
+//
+// when monitor encounters an AutocmdFired rpc it will forward the event to
+// every matching subscriber of the session.
+func (e *EventsService) monitor(ctx context.Context, s *Session, boxNumber uint32) {
+	for ctx.Err() == nil && s.channel.ChannelOpen() {
+		d := channel.Delivery{Channel: s.channel, BoxNum: boxNumber}
+		env, err := d.Wait(ctx)
+		if err != nil {
+			log.Printf("EventsService: received error waiting on mailbox %v: %v", boxNumber, err)
+			continue
+		}
+
+		ev := &pb.Event{}
+		err = jsonpb.Unmarshal(bytes.NewReader(env.Body), ev)
+		if err != nil {
+			log.Printf("EventsService: received error serializing json to Event %v: %v", boxNumber, err)
+			continue
+		}
+
+		e.Lock()
+		for sub := range e.subs {
+			if sub.session == s.info.Id && sub.match(ev) {
+				sub.deliver(ev)
+			}
+		}
+		e.Unlock()
+	}
+	log.Printf("EventsService: session %v monitor done: %v", s.info.Id, ctx.Err())
+}
+
+// acquire asks the session's Vim to broadcast the provided events and takes
+// a reference on each of them.
+//
+// An InvalidArgument error is returned if Vim does not know an event.
+func (e *EventsService) acquire(ctx context.Context, session string, events []string) error {
+	const (
+		RPC = "EnableAutocmds"
+	)
+	e.autocmdsMu.Lock()
+	defer e.autocmdsMu.Unlock()
+
+	resp := &pb.AutocmdsResponse{}
+	err := e.call(ctx, RPC, &pb.AutocmdsRequest{Events: events}, resp)
+	if err != nil {
+		return err
+	}
+	if len(resp.Unknown) > 0 {
+		// Vim installs nothing if an event is unknown.
+		return status.Errorf(codes.InvalidArgument, "unknown events: %v", strings.Join(resp.Unknown, ", "))
+	}
+
+	if e.autocmds[session] == nil {
+		e.autocmds[session] = map[string]int{}
+	}
+	for _, name := range events {
+		e.autocmds[session][strings.ToLower(name)]++
+	}
+	return nil
+}
+
+// release drops a reference on each of the provided events and asks the
+// session's Vim to remove the autocommands no longer referenced.
+func (e *EventsService) release(ctx context.Context, session string, events []string) {
+	e.autocmdsMu.Lock()
+	defer e.autocmdsMu.Unlock()
+
+	for _, name := range events {
+		e.autocmds[session][strings.ToLower(name)]--
+	}
+	e.reconcile(ctx, session)
+}
+
+// reconcile removes the autocommands of unreferenced events from the
+// session's Vim.
+//
+// must be called with autocmdsMu held.
+func (e *EventsService) reconcile(ctx context.Context, session string) {
+	const (
+		RPC = "DisableAutocmds"
+	)
+	var unused []string
+	for name, n := range e.autocmds[session] {
+		if n <= 0 {
+			unused = append(unused, name)
+			delete(e.autocmds[session], name)
+		}
+	}
+	if len(e.autocmds[session]) == 0 {
+		delete(e.autocmds, session)
+	}
+	if len(unused) == 0 {
+		return
+	}
+	err := e.call(ctx, RPC, &pb.AutocmdsRequest{Events: unused}, &pb.AutocmdsResponse{})
+	if err != nil {
+		log.Printf("EventsService: failed to remove autocommands %v: %v", unused, err)
+	}
+}
+
+// Subscribe streams the autocommand events matching the request's filters
+// until the client disconnects or the channel to Vim closes.
+//
+// A subscriber which cannot keep up with the events is disconnected
+// with a ResourceExhausted error.
+func (e *EventsService) Subscribe(req *pb.SubscribeRequest, stream pb.Events_SubscribeServer) error {
+	const (
+		// buffered events before a subscriber is considered behind.
+		backlog = 1024
+	)
+
+	if len(req.Events) == 0 {
+		return status.Errorf(codes.InvalidArgument, "at least one event is required")
+	}
+	if req.DebounceMs < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid debounce: %vms", req.DebounceMs)
+	}
+	if _, err := path.Match(req.Pattern, ""); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid pattern %q: %v", req.Pattern, err)
+	}
+
+	// de-duplicate event names so references are taken once.
+	var events []string
+	seen := map[string]bool{}
+	for _, name := range req.Events {
+		if name == "" || seen[strings.ToLower(name)] {
+			continue
+		}
+		seen[strings.ToLower(name)] = true
+		events = append(events, name)
+	}
+
+	sess, err := e.session(stream.Context())
+	if err != nil {
+		return err
+	}
+	ch := sess.channel
+	if !ch.ChannelOpen() {
+		return channel.ErrChanClosed
+	}
+	id := sess.info.Id
+
+	if err := e.acquire(withSession(stream.Context(), id), id, events); err != nil {
+		return err
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(withSession(context.Background(), id), 5*time.Second)
+		defer cancel()
+		e.release(ctx, id, events)
+	}()
+
+	s := &subscriber{
+		session:  id,
+		req:      req,
+		events:   make(chan *pb.Event, backlog),
+		overflow: make(chan struct{}),
+	}
+	e.Lock()
+	e.subs[s] = struct{}{}
+	e.Unlock()
+	defer func() {
+		e.Lock()
+		delete(e.subs, s)
+		e.Unlock()
+	}()
+
+	// pending holds debounced events in order of arrival, at most
+	// one per event name and buffer.
+	var pending []*pb.Event
+	debounce := time.Duration(req.DebounceMs) * time.Millisecond
+	timer := time.NewTimer(debounce)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-s.overflow:
+			return status.Errorf(codes.ResourceExhausted, "subscriber fell behind events")
+		case <-ch.Done():
+			return status.Errorf(codes.Unavailable, "channel closed during subscription")
+		case ev := <-s.events:
+			if debounce == 0 {
+				if err := stream.Send(ev); err != nil {
+					return err
+				}
+				continue
+			}
+			for i, p := range pending {
+				if strings.EqualFold(p.Event, ev.Event) && p.Bufnr == ev.Bufnr {
+					pending = append(pending[:i], pending[i+1:]...)
+					break
+				}
+			}
+			pending = append(pending, ev)
+			timer.Reset(debounce)
+		case <-timer.C:
+			for _, ev := range pending {
+				if err := stream.Send(ev); err != nil {
+					return err
+				}
+			}
+			pending = nil
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/ldelossa/vim-grpc.vim/channel"
+	pb "github.com/ldelossa/vim-grpc.vim/proto/keymaps"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// KeymapRecord is a record structure for book-keeping
+// registered extension mappings.
+type KeymapRecord struct {
+	// session the mapping is registered in.
+	session    string
+	definition *pb.KeymapDefinition
+	stream     pb.Keymaps_RegisterKeymapServer
+	// serializes Sends on stream, a stream does not support
+	// concurrent Sends.
+	send *sync.Mutex
+}
+
+// KeymapsService handles extension mapping registration and monitors the
+// channel's keymap mailboxes for uses of the registered mappings.
+//
+// Mappings follow the lifecycle of registered commands, they are removed
+// from Vim when the registering stream closes and registered again when
+// Vim reconnects.
+type KeymapsService struct {
+	*Proxy
+	pb.UnimplementedKeymapsServer
+	sync.Mutex
+	// records keyed by mapping id.
+	keymaps map[int64]KeymapRecord
+	// id of the next mapping.
+	id int64
+}
+
+func NewKeymapsService(ctx context.Context, proxy *Proxy) *KeymapsService {
+	ks := &KeymapsService{
+		Proxy:   proxy,
+		keymaps: map[int64]KeymapRecord{},
+	}
+	return ks
+}
+
+// monitor watches the provided mailbox number of a session for incoming KeymapTriggered rpcs.
+//
+// when monitor encounters a KeymapTriggered rpc it will forward this event to the
+// extension which registered the mapping.
+func (k *KeymapsService) monitor(ctx context.Context, s *Session, boxNumber uint32) {
+	for ctx.Err() == nil && s.channel.ChannelOpen() {
+		d := channel.Delivery{Channel: s.channel, BoxNum: boxNumber}
+		env, err := d.Wait(ctx)
+		if err != nil {
+			log.Printf("KeymapsService: received error waiting on mailbox %v: %v", boxNumber, err)
+			continue
+		}
+
+		event := &pb.KeymapTriggered{}
+		err = jsonpb.Unmarshal(bytes.NewReader(env.Body), event)
+		if err != nil {
+			log.Printf("KeymapsService: received error serializing json to KeymapTriggered event %v: %v", boxNumber, err)
+			continue
+		}
+
+		err = k.trigger(s, event)
+		if err != nil {
+			log.Printf("KeymapsService: failed to forward mapping use: %v", err)
+		}
+	}
+	log.Printf("KeymapsService: session %v monitor done: %v", s.info.Id, ctx.Err())
+}
+
+func (k *KeymapsService) trigger(s *Session, event *pb.KeymapTriggered) error {
+	k.Lock()
+	rec, ok := k.keymaps[event.Id]
+	k.Unlock()
+	if !ok || rec.session != s.info.Id {
+		return fmt.Errorf("mapping %v does not exist", event.Id)
+	}
+	rec.send.Lock()
+	defer rec.send.Unlock()
+	return rec.stream.Send(&pb.KeymapEvent{Event: &pb.KeymapEvent_Triggered{Triggered: event}})
+}
+
+// RegisterKeymap will attempt to map the provided keys in Vim.
+// On success the Server side stream will be held open and the client will receive
+// receipt of a use of the mapping via calling Recv on its side of the stream.
+//
+// If the channel to Vim disconnects the mapping is registered again once
+// Vim reconnects, see resync.
+// If the client disconnects the stream will be closed and the mapping is
+// removed from Vim.
+func (k *KeymapsService) RegisterKeymap(req *pb.RegisterKeymapRequest, stream pb.Keymaps_RegisterKeymapServer) error {
+	const (
+		RPC = "RegisterKeymap"
+	)
+
+	if err := validKeymap(req); err != nil {
+		return err
+	}
+
+	s, err := k.session(stream.Context())
+	if err != nil {
+		return err
+	}
+	ctx := withSession(stream.Context(), s.info.Id)
+
+	k.Lock()
+	for _, rec := range k.keymaps {
+		if rec.session != s.info.Id {
+			continue
+		}
+		if conflict := keymapConflict(rec.definition.Keymap, req); conflict != "" {
+			k.Unlock()
+			return status.Errorf(codes.AlreadyExists, "%v is already mapped by %v", conflict, rec.definition.Keymap.Extension)
+		}
+	}
+	k.id++
+	def := &pb.KeymapDefinition{Id: k.id, Keymap: req}
+	k.Unlock()
+
+	var reg pb.KeymapRegistration
+	err = k.call(ctx, RPC, def, &reg)
+	if err != nil {
+		return err
+	}
+	if !reg.Registered {
+		return fmt.Errorf("registration failed: %v", reg.Reason)
+	}
+	reg.Id = def.Id
+
+	rec := KeymapRecord{
+		session:    s.info.Id,
+		definition: def,
+		stream:     stream,
+		send:       &sync.Mutex{},
+	}
+	// the registration is the first event on the stream, uses of the
+	// mapping wait on it.
+	rec.send.Lock()
+	k.Lock()
+	k.keymaps[def.Id] = rec
+	k.Unlock()
+	defer k.remove(rec)
+
+	err = stream.Send(&pb.KeymapEvent{
+		Event: &pb.KeymapEvent_Registration{Registration: &reg},
+	})
+	rec.send.Unlock()
+	if err != nil {
+		return err
+	}
+
+	<-stream.Context().Done()
+	return stream.Context().Err()
+}
+
+// remove deletes the record of a closed stream and asks Vim to remove
+// its mapping.
+func (k *KeymapsService) remove(rec KeymapRecord) {
+	const (
+		RPC = "UnregisterKeymap"
+	)
+
+	k.Lock()
+	delete(k.keymaps, rec.definition.Id)
+	k.Unlock()
+
+	ctx, cancel := context.WithTimeout(withSession(context.Background(), rec.session), 5*time.Second)
+	defer cancel()
+	err := k.call(ctx, RPC, rec.definition, &pb.KeymapRegistration{})
+	if err != nil {
+		log.Printf("KeymapsService: failed to remove mapping %v: %v", rec.definition.Id, err)
+	}
+}
+
+// resync registers the recorded mappings of the session the ctx routes
+// to with its newly connected Vim.
+//
+// Vim removes its registered mappings when the channel closes.
+func (k *KeymapsService) resync(ctx context.Context) {
+	const (
+		RPC = "RegisterKeymap"
+	)
+
+	s, err := k.session(ctx)
+	if err != nil {
+		log.Printf("KeymapsService: failed to register mappings again: %v", err)
+		return
+	}
+
+	var recs []KeymapRecord
+	k.Lock()
+	for _, rec := range k.keymaps {
+		if rec.session == s.info.Id {
+			recs = append(recs, rec)
+		}
+	}
+	k.Unlock()
+
+	for _, rec := range recs {
+		tctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		var reg pb.KeymapRegistration
+		err := k.call(tctx, RPC, rec.definition, &reg)
+		cancel()
+		if err == nil && !reg.Registered {
+			err = fmt.Errorf("registration failed: %v", reg.Reason)
+		}
+		if err != nil {
+			log.Printf("KeymapsService: failed to register mapping %v again: %v", rec.definition.Id, err)
+		}
+	}
+}
+
+// validKeymap validates the arguments of a mapping registration.
+func validKeymap(req *pb.RegisterKeymapRequest) error {
+	if req.Extension == "" || req.Lhs == "" {
+		return status.Errorf(codes.InvalidArgument, "extension and lhs are required")
+	}
+	if strings.ContainsAny(req.Lhs, " \t\n|") {
+		return status.Errorf(codes.InvalidArgument, "lhs must use <Space> and <Bar> notation: %q", req.Lhs)
+	}
+	if len(req.Modes) == 0 {
+		return status.Errorf(codes.InvalidArgument, "at least one mode is required")
+	}
+	for _, m := range req.Modes {
+		if _, ok := pb.Mode_name[int32(m)]; !ok {
+			return status.Errorf(codes.InvalidArgument, "invalid mode: %v", m)
+		}
+	}
+	if req.Bufnr < 0 || (req.Bufnr != 0 && !req.Buffer) {
+		return status.Errorf(codes.InvalidArgument, "bufnr requires a buffer local mapping")
+	}
+	return nil
+}
+
+// keymapConflict returns the mode and keys two mappings share, an empty
+// string if they do not overlap.
+//
+// Global and buffer-local mappings do not conflict, the latter take
+// precedence in their buffer.
+func keymapConflict(a *pb.RegisterKeymapRequest, b *pb.RegisterKeymapRequest) string {
+	if a.Lhs != b.Lhs || a.Buffer != b.Buffer || a.Bufnr != b.Bufnr {
+		return ""
+	}
+	for _, am := range a.Modes {
+		for _, bm := range b.Modes {
+			if am == bm {
+				return fmt.Sprintf("%v in %v mode", a.Lhs, am)
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,111 @@
+package proxy
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// UnixScheme prefixes Unix socket addresses, matching
+	// the addresses accepted by Vim's ch_open.
+	UnixScheme = "unix:"
+	// VimSocket names the default socket Vim connects to.
+	VimSocket = "vim.sock"
+	// GRPCSocket names the default socket gRPC clients connect to.
+	GRPCSocket = "grpc.sock"
+)
+
+// SocketDir returns the per-user directory holding the default sockets.
+//
+// The directory is $XDG_RUNTIME_DIR/vgrpc, or vgrpc-<uid> in the
+// temporary directory if XDG_RUNTIME_DIR is unset.
+func SocketDir() string {
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" {
+		return filepath.Join(dir, "vgrpc")
+	}
+	return filepath.Join(os.TempDir(), "vgrpc-"+strconv.Itoa(os.Getuid()))
+}
+
+// SocketPath returns the path of a Unix socket address and true,
+// or false if addr is a TCP address.
+//
+// The address "unix:" alone resolves to the socket called name in
+// SocketDir.
+func SocketPath(addr string, name string) (string, bool) {
+	if !strings.HasPrefix(addr, UnixScheme) {
+		return "", false
+	}
+	path := strings.TrimPrefix(addr, UnixScheme)
+	if path == "" {
+		path = filepath.Join(SocketDir(), name)
+	}
+	return path, true
+}
+
+// NewListener creates a listener for addr.
+//
+// An addr of the form "unix:<path>" creates a Unix socket only the current
+// user may connect to, "unix:" alone the socket called name in SocketDir.
+// Any other addr is a TCP "host:port".
+func NewListener(addr string, name string) (net.Listener, error) {
+	path, ok := SocketPath(addr, name)
+	if !ok {
+		return net.Listen(Network, addr)
+	}
+
+	dir := filepath.Dir(path)
+	if dir == SocketDir() {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, err
+		}
+	}
+	// the directory guards the socket between its creation
+	// and the chmod below.
+	fi, err := os.Lstat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() || fi.Mode().Perm()&0077 != 0 {
+		return nil, fmt.Errorf("socket directory %v must be a directory accessible only by its owner, has mode %v", dir, fi.Mode())
+	}
+
+	if err := removeStale(path); err != nil {
+		return nil, err
+	}
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		lis.Close()
+		return nil, err
+	}
+	return lis, nil
+}
+
+// removeStale removes a socket left behind at path by an exited daemon.
+//
+// An error is returned if path is not a socket or a daemon still
+// listens on it.
+func removeStale(path string) error {
+	fi, err := os.Lstat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if fi.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%v exists and is not a socket", path)
+	}
+	if conn, err := net.Dial("unix", path); err == nil {
+		conn.Close()
+		return fmt.Errorf("%v is in use by another process", path)
+	}
+	return os.Remove(path)
+}
@@ -1,11 +1,16 @@
 package proxy
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"log"
 	"net"
 	"sync"
+	"time"
 
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
 	"github.com/ldelossa/vim-grpc.vim/channel"
 )
 
@@ -14,81 +19,240 @@ const (
 	DefaultPort = 7999
 )
 
+// Options configures the Vim channels of a Proxy.
+type Options struct {
+	// time between heartbeats sent to Vim.
+	PingInterval time.Duration
+	// time Vim has to answer a heartbeat before its channel is closed.
+	PingTimeout time.Duration
+	// mailboxes each Vim channel starts with, see channel.NewChannel.
+	MailboxCapacity int
+	// mailboxes each Vim channel may grow to.
+	MaxMailboxCapacity int
+}
+
+// DefaultOptions returns the Options of a Proxy when not configured.
+func DefaultOptions() Options {
+	return Options{
+		PingInterval:       channel.DefaultPingInterval,
+		PingTimeout:        channel.DefaultPingTimeout,
+		MailboxCapacity:    channel.DefaultMailboxCapacity,
+		MaxMailboxCapacity: channel.DefaultMaxMailboxCapacity,
+	}
+}
+
 // Proxy implements our gRPC client <-> Vim
 // multiplexing proxy.
 //
 // The Proxy embeds individual gRPC services exposing a namespaced
 // RPC API to gRPC clients.
 //
+// The Proxy holds a channel per connected Vim, see Session. Calls are
+// routed to the session named by the client's "vgrpc-session" metadata,
+// falling back to the most recently focused Vim.
+//
 // A Proxy must always be constructed by its NewProxy constructor
-// to properly initialize a disconnected channel.
+// to properly initialize its sessions.
 type Proxy struct {
 	// embedded services promote gRPC service implementation methods
 	// making Proxy usable in all gRPC registration
 	// methods.
 	*EnvironmentService
 	*CommandsService
+	*BufferService
+	*EventsService
+	*EditorService
+	*WindowsService
+	*UIService
+	*QuickfixService
+	*DiagnosticsService
+	*CompletionService
+	*KeymapsService
+	*SessionsService
 	sync.RWMutex
-	channel channel.Channel
+	// connected Vims keyed by session id.
+	sessions map[string]*Session
+	opts     Options
 }
 
-func NewProxy(ctx context.Context) *Proxy {
-	p := &Proxy{}
-	p.channel = channel.Channel{State: new(int32)}
+func NewProxy(ctx context.Context, opts Options) *Proxy {
+	p := &Proxy{
+		sessions: map[string]*Session{},
+		opts:     opts,
+	}
 	// register services.
 	p.EnvironmentService = NewEnvService(ctx, p)
 	p.CommandsService = NewCommandsService(ctx, p)
+	p.BufferService = NewBufferService(ctx, p)
+	p.EventsService = NewEventsService(ctx, p)
+	p.EditorService = NewEditorService(ctx, p)
+	p.WindowsService = NewWindowsService(ctx, p)
+	p.UIService = NewUIService(ctx, p)
+	p.QuickfixService = NewQuickfixService(ctx, p)
+	p.DiagnosticsService = NewDiagnosticsService(ctx, p)
+	p.CompletionService = NewCompletionService(ctx, p)
+	p.KeymapsService = NewKeymapsService(ctx, p)
+	p.SessionsService = NewSessionsService(ctx, p)
 	return p
 }
 
-// Listen will create a TCP socket for
-// Vim to connect to.
+// Listen will create a socket at addr for
+// Vim to connect to, see NewListener.
 //
 // Once a connection is made a channel.Channel
-// will be created from the net.TCPConn
+// will be created from the net.Conn
+// and served as a Session.
 //
-// Any gRPC requests made while a Channel is not
-// initialized will error.
+// Any gRPC requests made while no Vim is
+// connected will error.
 //
-// Proxy will block on a single Vim channel
-// and will not concurrently handle multiple.
-func (p *Proxy) Listen(ctx context.Context) error {
-	listener, err := net.ListenTCP(Network, &net.TCPAddr{
-		Port: DefaultPort,
-	})
+// Proxy serves every Vim connection
+// concurrently.
+func (p *Proxy) Listen(ctx context.Context, addr string) error {
+	listener, err := NewListener(addr, VimSocket)
 	if err != nil {
 		return err
 	}
+	go func() {
+		// removes a Unix socket on shutdown.
+		<-ctx.Done()
+		listener.Close()
+	}()
 
 	for {
-		conn, err := listener.AcceptTCP()
+		conn, err := listener.Accept()
 		if err != nil {
-			log.Printf("err tcp during connection: %v", err)
+			if ctx.Err() != nil {
+				return nil
+			}
+			log.Printf("err during connection: %v", err)
 			continue
 		}
 		log.Printf("proxy: received new connect")
+		go p.serve(ctx, conn)
+	}
+}
 
-		p.Lock()
-		p.channel = channel.NewChannel(conn)
-		p.Unlock()
+// serve registers the Vim connected over conn as a session and blocks
+// until its channel closes.
+func (p *Proxy) serve(ctx context.Context, conn net.Conn) {
+	ch := channel.NewChannel(conn, p.opts.MailboxCapacity, p.opts.MaxMailboxCapacity)
+	// kick off recv and send sides
+	go ch.Recv(ctx)
+	go ch.Transmit(ctx)
 
-		log.Printf("proxy: channel connected")
-		// kick off recv side
-		go p.channel.Recv(ctx)
-		// blocks until ctx is canceled or an underlying
-		// tcp error is detected.
-		p.channel.Ping(ctx)
-		log.Printf("proxy: channel disconnected")
+	s, err := p.handshake(ctx, ch)
+	if err != nil {
+		log.Printf("proxy: session handshake failed, closing channel: %v", err)
+		ch.Close()
+		return
 	}
+	id := s.info.Id
+
+	sctx, cancel := context.WithCancel(ctx)
+	p.monitor(sctx, s)
+
+	p.Lock()
+	// a Vim reconnecting replaces its previous channel.
+	if prev, ok := p.sessions[id]; ok {
+		prev.channel.Close()
+	}
+	p.sessions[id] = s
+	p.Unlock()
+	log.Printf("proxy: session %v connected", id)
+
+	// a new Vim knows nothing of the registered commands and mappings.
+	go p.CommandsService.resync(withSession(sctx, id))
+	go p.KeymapsService.resync(withSession(sctx, id))
+
+	// blocks until ctx is canceled or an underlying
+	// tcp error is detected.
+	ch.Ping(ctx, p.opts.PingInterval, p.opts.PingTimeout)
+	cancel()
+
+	p.Lock()
+	if p.sessions[id] == s {
+		delete(p.sessions, id)
+	}
+	p.Unlock()
+	log.Printf("proxy: session %v disconnected", id)
 }
 
-// Channel returns the Vim channel associated with the
-// Proxy.
+// monitor starts the monitors of a session's broadcast mailboxes.
+//
+// The monitors return once the session's channel closes.
+func (p *Proxy) monitor(ctx context.Context, s *Session) {
+	for i := channel.CMDBoxNumOffset; i < channel.BufEvBoxNumOffset; i++ {
+		go p.CommandsService.monitor(ctx, s, i)
+	}
+	for i := channel.BufEvBoxNumOffset; i < channel.EventBoxNumOffset; i++ {
+		go p.BufferService.monitor(ctx, s, i)
+	}
+	for i := channel.EventBoxNumOffset; i < channel.PopupBoxNumOffset; i++ {
+		go p.EventsService.monitor(ctx, s, i)
+	}
+	for i := channel.PopupBoxNumOffset; i < channel.CmplBoxNumOffset; i++ {
+		go p.UIService.monitor(ctx, s, i)
+	}
+	for i := channel.CmplBoxNumOffset; i < channel.KeymapBoxNumOffset; i++ {
+		go p.CompletionService.monitor(ctx, s, i)
+	}
+	for i := channel.KeymapBoxNumOffset; i < channel.SessionBoxNumOffset; i++ {
+		go p.KeymapsService.monitor(ctx, s, i)
+	}
+	for i := channel.SessionBoxNumOffset; i < channel.RPCBoxNumOffset; i++ {
+		go p.SessionsService.monitor(ctx, s, i)
+	}
+}
+
+// Channel returns the Vim channel of the session
+// the ctx routes to.
 //
 // The Channel is not guaranteed to be open.
-func (p *Proxy) Channel() channel.Channel {
-	p.Lock()
-	ch := p.channel
-	p.Unlock()
-	return ch
+func (p *Proxy) Channel(ctx context.Context) (channel.Channel, error) {
+	s, err := p.session(ctx)
+	if err != nil {
+		return channel.Channel{}, err
+	}
+	return s.channel, nil
+}
+
+// call marshals req, delivers it to the Vim the ctx routes to as the
+// named RPC and unmarshals Vim's response body into resp.
+//
+// call blocks until Vim responds or the ctx is canceled.
+func (p *Proxy) call(ctx context.Context, rpc string, req proto.Message, resp proto.Message) error {
+	ch, err := p.Channel(ctx)
+	if err != nil {
+		return err
+	}
+	if !ch.ChannelOpen() {
+		return channel.ErrChanClosed
+	}
+
+	m := jsonpb.Marshaler{
+		EmitDefaults: false,
+	}
+
+	var b bytes.Buffer
+	err = m.Marshal(&b, req)
+	if err != nil {
+		return err
+	}
+
+	e := channel.Envelope{
+		RPC:  rpc,
+		Body: b.Bytes(),
+	}
+
+	e, err = ch.Send(ctx, &e).Wait(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = jsonpb.Unmarshal(bytes.NewReader(e.Body), resp)
+	if err != nil {
+		return fmt.Errorf("failed decoding %v response: %v", rpc, err)
+	}
+	return nil
 }
@@ -0,0 +1,113 @@
+package proxy
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/ldelossa/vim-grpc.vim/proto/quickfix"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// QuickfixService provides RPCs publishing to and reading from
+// Vim's quickfix and location lists.
+//
+// Every RPC acts on the quickfix list when no window id is provided
+// and on the window's location list otherwise.
+type QuickfixService struct {
+	*Proxy
+	pb.UnimplementedQuickfixServer
+}
+
+func NewQuickfixService(ctx context.Context, proxy *Proxy) *QuickfixService {
+	return &QuickfixService{
+		Proxy: proxy,
+	}
+}
+
+// SetList replaces, or appends to, a quickfix or location list.
+func (q *QuickfixService) SetList(ctx context.Context, req *pb.SetListRequest) (*pb.SetListResponse, error) {
+	const (
+		RPC = "SetList"
+	)
+
+	for i, item := range req.Items {
+		if item.Filename == "" && item.Bufnr <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "item %d: filename or bufnr is required", i)
+		}
+		if len(item.Type) > 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "item %d: type must be a single character: %q", i, item.Type)
+		}
+	}
+
+	res, err := q.list(ctx, RPC, req, req.Winid)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetListResponse{Id: res.Id}, nil
+}
+
+// GetList returns the current quickfix or location list.
+func (q *QuickfixService) GetList(ctx context.Context, req *pb.GetListRequest) (*pb.GetListResponse, error) {
+	const (
+		RPC = "GetList"
+	)
+
+	res, err := q.list(ctx, RPC, req, req.Winid)
+	if err != nil {
+		return nil, err
+	}
+	if res.List == nil {
+		return &pb.GetListResponse{}, nil
+	}
+	return res.List, nil
+}
+
+// OpenList opens the quickfix or location list window and returns
+// its window id.
+func (q *QuickfixService) OpenList(ctx context.Context, req *pb.OpenListRequest) (*pb.OpenListResponse, error) {
+	const (
+		RPC = "OpenList"
+	)
+
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height: %v", req.Height)
+	}
+
+	res, err := q.list(ctx, RPC, req, req.Winid)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.OpenListResponse{Winid: res.Id}, nil
+}
+
+// CloseList closes the quickfix or location list window.
+func (q *QuickfixService) CloseList(ctx context.Context, req *pb.CloseListRequest) (*pb.CloseListResponse, error) {
+	const (
+		RPC = "CloseList"
+	)
+
+	_, err := q.list(ctx, RPC, req, req.Winid)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CloseListResponse{}, nil
+}
+
+// list issues a list RPC and maps Vim's ListResult to a gRPC
+// status error on failure.
+func (q *QuickfixService) list(ctx context.Context, rpc string, req proto.Message, winid int64) (*pb.ListResult, error) {
+	if winid < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid window id: %v", winid)
+	}
+
+	res := &pb.ListResult{}
+	err := q.call(ctx, rpc, req, res)
+	if err != nil {
+		return nil, err
+	}
+	if !res.Found {
+		return nil, status.Errorf(codes.NotFound, "window %v not found", winid)
+	}
+	return res, nil
+}
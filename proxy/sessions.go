@@ -0,0 +1,194 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/ldelossa/vim-grpc.vim/channel"
+	pb "github.com/ldelossa/vim-grpc.vim/proto/sessions"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// MetadataSession is the gRPC metadata key naming the session
+	// a call is routed to.
+	MetadataSession = "vgrpc-session"
+)
+
+// Session is a Vim connected to the Proxy.
+type Session struct {
+	info    *pb.SessionInfo
+	channel channel.Channel
+	// time Vim last gained focus, or connected.
+	// guarded by the Proxy's lock.
+	focused time.Time
+}
+
+// sessionKey is the context key of a session id pinned by withSession.
+type sessionKey struct{}
+
+// withSession returns a ctx routing calls to the session with the
+// provided id, regardless of the client's metadata.
+//
+// Streams pin the session they were opened against so their calls
+// do not follow the focus to another Vim.
+func withSession(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, sessionKey{}, id)
+}
+
+// session returns the session the ctx routes to.
+//
+// A NotFound error is returned if the requested session is not
+// connected, channel.ErrChanClosed if no Vim is connected at all.
+func (p *Proxy) session(ctx context.Context) (*Session, error) {
+	id, _ := ctx.Value(sessionKey{}).(string)
+	if id == "" {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if v := md.Get(MetadataSession); len(v) > 0 {
+				id = v[0]
+			}
+		}
+	}
+
+	p.RLock()
+	defer p.RUnlock()
+	if id != "" {
+		s, ok := p.sessions[id]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "session %v not connected", id)
+		}
+		return s, nil
+	}
+	var focused *Session
+	for _, s := range p.sessions {
+		if focused == nil || s.focused.After(focused.focused) {
+			focused = s
+		}
+	}
+	if focused == nil {
+		return nil, channel.ErrChanClosed
+	}
+	return focused, nil
+}
+
+// handshake asks a newly connected Vim to describe its session.
+func (p *Proxy) handshake(ctx context.Context, ch channel.Channel) (*Session, error) {
+	const (
+		RPC = "Session"
+	)
+
+	m := jsonpb.Marshaler{
+		EmitDefaults: false,
+	}
+
+	var b bytes.Buffer
+	err := m.Marshal(&b, &pb.SessionRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	e := channel.Envelope{
+		RPC:  RPC,
+		Body: b.Bytes(),
+	}
+
+	tctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	e, err = ch.Send(tctx, &e).Wait(tctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &pb.SessionInfo{}
+	err = jsonpb.Unmarshal(bytes.NewReader(e.Body), info)
+	if err != nil {
+		return nil, err
+	}
+	if info.Id == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Vim reported an empty session id")
+	}
+	return &Session{
+		info:    info,
+		channel: ch,
+		focused: time.Now(),
+	}, nil
+}
+
+// SessionsService lists the connected Vims and monitors the channel's
+// session mailboxes for Vim gaining focus.
+type SessionsService struct {
+	*Proxy
+	pb.UnimplementedSessionsServer
+}
+
+func NewSessionsService(ctx context.Context, proxy *Proxy) *SessionsService {
+	return &SessionsService{
+		Proxy: proxy,
+	}
+}
+
+// monitor watches the provided mailbox number of a session for incoming
+// SessionFocused rpcs.
+//
+// when monitor encounters a SessionFocused rpc calls without session
+// metadata are routed to the session.
+func (s *SessionsService) monitor(ctx context.Context, sess *Session, boxNumber uint32) {
+	for ctx.Err() == nil && sess.channel.ChannelOpen() {
+		d := channel.Delivery{Channel: sess.channel, BoxNum: boxNumber}
+		env, err := d.Wait(ctx)
+		if err != nil {
+			log.Printf("SessionsService: received error waiting on mailbox %v: %v", boxNumber, err)
+			continue
+		}
+
+		ev := &pb.SessionFocused{}
+		err = jsonpb.Unmarshal(bytes.NewReader(env.Body), ev)
+		if err != nil {
+			log.Printf("SessionsService: received error serializing json to SessionFocused event %v: %v", boxNumber, err)
+			continue
+		}
+
+		s.Lock()
+		sess.focused = time.Now()
+		s.Unlock()
+	}
+	log.Printf("SessionsService: session %v monitor done: %v", sess.info.Id, ctx.Err())
+}
+
+// ListSessions returns the connected Vims ordered by session id.
+func (s *SessionsService) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	resp := &pb.ListSessionsResponse{}
+	focused, err := s.session(context.Background())
+	if err != nil {
+		return resp, nil
+	}
+
+	s.RLock()
+	for _, sess := range s.sessions {
+		info := proto.Clone(sess.info).(*pb.SessionInfo)
+		info.Focused = sess == focused
+		stats := sess.channel.Stats()
+		info.Mailboxes = &pb.MailboxStats{
+			Capacity:    int64(stats.Capacity),
+			MaxCapacity: int64(stats.MaxCapacity),
+			InUse:       int64(stats.InUse),
+			PeakInUse:   int64(stats.PeakInUse),
+			Waited:      stats.Waited,
+			Rejected:    stats.Rejected,
+			Quarantined: stats.Quarantined,
+		}
+		resp.Sessions = append(resp.Sessions, info)
+	}
+	s.RUnlock()
+	sort.Slice(resp.Sessions, func(i, j int) bool {
+		return resp.Sessions[i].Id < resp.Sessions[j].Id
+	})
+	return resp, nil
+}
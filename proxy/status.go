@@ -0,0 +1,71 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/ldelossa/vim-grpc.vim/channel"
+	editor "github.com/ldelossa/vim-grpc.vim/proto/editor"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatus maps channel errors and the errors Vim answers with to their
+// gRPC status, other errors are returned as is.
+//
+// A closed channel, Vim exiting or crashing, maps to Unavailable.
+func toStatus(err error) error {
+	var verr *channel.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &verr):
+		return vimStatus(verr)
+	case errors.Is(err, channel.ErrMailboxesExhausted):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, channel.ErrChanClosed):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+	return err
+}
+
+// vimStatus converts the error a Vim handler answered with into a status
+// error with its code. An exception raised by the handler is carried as
+// a VimError detail.
+func vimStatus(verr *channel.Error) error {
+	var code codes.Code
+	if err := code.UnmarshalJSON([]byte(strconv.Quote(verr.Code))); err != nil || code == codes.OK {
+		code = codes.Unknown
+	}
+	st := status.New(code, verr.Message)
+	if ex := verr.Exception; ex != nil {
+		detail := &editor.VimError{
+			Code:       ex.Code,
+			Message:    ex.Message,
+			Throwpoint: ex.Throwpoint,
+		}
+		if detailed, err := st.WithDetails(detail); err == nil {
+			st = detailed
+		}
+	}
+	return st.Err()
+}
+
+// UnaryInterceptor maps the channel errors of unary rpcs to their
+// gRPC status, see grpc.UnaryInterceptor.
+func UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	return resp, toStatus(err)
+}
+
+// StreamInterceptor maps the channel errors of streaming rpcs to their
+// gRPC status, see grpc.StreamInterceptor.
+func StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return toStatus(handler(srv, ss))
+}
@@ -0,0 +1,196 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/ldelossa/vim-grpc.vim/channel"
+	pb "github.com/ldelossa/vim-grpc.vim/proto/ui"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// popupStream is the server side stream of ShowInfo and ShowMenu.
+type popupStream interface {
+	Send(*pb.PopupEvent) error
+	Context() context.Context
+}
+
+// UIService provides RPCs displaying popups and notifications to the user.
+//
+// UIService monitors the channel's popup mailboxes and forwards the
+// closing of a popup to the extension which opened it.
+type UIService struct {
+	*Proxy
+	pb.UnimplementedUIServer
+	sync.Mutex
+	// token of the next popup opened.
+	token int64
+	// open popups keyed by token.
+	popups map[int64]chan *pb.PopupCallback
+}
+
+func NewUIService(ctx context.Context, proxy *Proxy) *UIService {
+	us := &UIService{
+		Proxy:  proxy,
+		popups: map[int64]chan *pb.PopupCallback{},
+	}
+	return us
+}
+
+// monitor watches the provided mailbox number of a session for incoming PopupClosed rpcs.
+//
+// when monitor encounters a PopupClosed rpc it will forward the callback to
+// the stream which opened the popup.
+func (u *UIService) monitor(ctx context.Context, s *Session, boxNumber uint32) {
+	for ctx.Err() == nil && s.channel.ChannelOpen() {
+		d := channel.Delivery{Channel: s.channel, BoxNum: boxNumber}
+		env, err := d.Wait(ctx)
+		if err != nil {
+			log.Printf("UIService: received error waiting on mailbox %v: %v", boxNumber, err)
+			continue
+		}
+
+		cb := &pb.PopupCallback{}
+		err = jsonpb.Unmarshal(bytes.NewReader(env.Body), cb)
+		if err != nil {
+			log.Printf("UIService: received error serializing json to PopupCallback %v: %v", boxNumber, err)
+			continue
+		}
+
+		u.Lock()
+		if c, ok := u.popups[cb.Token]; ok {
+			// buffered, a popup closes once.
+			c <- cb
+			delete(u.popups, cb.Token)
+		}
+		u.Unlock()
+	}
+	log.Printf("UIService: session %v monitor done: %v", s.info.Id, ctx.Err())
+}
+
+// Notify displays a notification which closes on its own
+// after a timeout.
+func (u *UIService) Notify(ctx context.Context, req *pb.NotifyRequest) (*pb.NotifyResponse, error) {
+	const (
+		RPC = "Notify"
+	)
+
+	if len(req.Lines) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one line is required")
+	}
+	if req.TimeMs < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid time: %vms", req.TimeMs)
+	}
+
+	resp := &pb.NotifyResponse{}
+	err := u.call(ctx, RPC, req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ShowInfo displays an info box next to the cursor.
+//
+// The stream receives a PopupOpened event followed by a dismissed
+// PopupClosed event once the info box closes, after which the stream
+// ends. Canceling the stream closes the info box.
+func (u *UIService) ShowInfo(req *pb.ShowInfoRequest, stream pb.UI_ShowInfoServer) error {
+	if len(req.Lines) == 0 {
+		return status.Errorf(codes.InvalidArgument, "at least one line is required")
+	}
+	return u.popup(&pb.OpenPopupRequest{
+		Popup: &pb.OpenPopupRequest_Info{Info: req},
+	}, nil, stream)
+}
+
+// ShowMenu displays a menu the user selects an item from.
+//
+// The stream receives a PopupOpened event followed by a PopupClosed
+// event carrying the selected item, or reporting the menu was dismissed,
+// after which the stream ends. Canceling the stream closes the menu.
+func (u *UIService) ShowMenu(req *pb.ShowMenuRequest, stream pb.UI_ShowMenuServer) error {
+	if len(req.Items) == 0 {
+		return status.Errorf(codes.InvalidArgument, "at least one item is required")
+	}
+	return u.popup(&pb.OpenPopupRequest{
+		Popup: &pb.OpenPopupRequest_Menu{Menu: req},
+	}, req.Items, stream)
+}
+
+// popup opens the requested popup and streams its events until it closes.
+//
+// items are the popup's selectable items, if any.
+func (u *UIService) popup(req *pb.OpenPopupRequest, items []string, stream popupStream) error {
+	const (
+		RPC      = "OpenPopup"
+		CloseRPC = "ClosePopup"
+	)
+
+	s, err := u.session(stream.Context())
+	if err != nil {
+		return err
+	}
+	ch := s.channel
+	if !ch.ChannelOpen() {
+		return channel.ErrChanClosed
+	}
+	ctx := withSession(stream.Context(), s.info.Id)
+
+	closed := make(chan *pb.PopupCallback, 1)
+	u.Lock()
+	u.token++
+	req.Token = u.token
+	u.popups[req.Token] = closed
+	u.Unlock()
+	defer func() {
+		u.Lock()
+		delete(u.popups, req.Token)
+		u.Unlock()
+	}()
+
+	opened := &pb.PopupOpened{}
+	err = u.call(ctx, RPC, req, opened)
+	if err != nil {
+		return err
+	}
+
+	err = stream.Send(&pb.PopupEvent{
+		Event: &pb.PopupEvent_Opened{Opened: opened},
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			ctx, cancel := context.WithTimeout(withSession(context.Background(), s.info.Id), 5*time.Second)
+			defer cancel()
+			err := u.call(ctx, CloseRPC, &pb.ClosePopupRequest{PopupId: opened.PopupId}, &pb.PopupOpened{})
+			if err != nil {
+				log.Printf("UIService: failed to close popup %v: %v", opened.PopupId, err)
+			}
+			return stream.Context().Err()
+		case <-ch.Done():
+			return status.Errorf(codes.Unavailable, "channel closed while popup %v was open", opened.PopupId)
+		case cb := <-closed:
+			event := &pb.PopupClosed{
+				PopupId:   cb.PopupId,
+				Dismissed: cb.Result < 1 || cb.Result > int64(len(items)),
+			}
+			if !event.Dismissed {
+				event.Index = cb.Result
+				event.Item = items[cb.Result-1]
+			}
+			return stream.Send(&pb.PopupEvent{
+				Event: &pb.PopupEvent_Closed{Closed: event},
+			})
+		}
+	}
+}
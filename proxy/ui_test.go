@@ -0,0 +1,49 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	ui "github.com/ldelossa/vim-grpc.vim/proto/ui"
+)
+
+// TestShowMenuConcurrent opens two menus at once and closes them in
+// reverse order, each stream must receive the close of its own menu.
+func TestShowMenuConcurrent(t *testing.T) {
+	conn := startVim(t)
+	client := ui.NewUIClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	menus := [][]string{{"a1", "a2"}, {"b1", "b2", "b3"}}
+	streams := make([]ui.UI_ShowMenuClient, len(menus))
+	ids := make([]int64, len(menus))
+	for i, items := range menus {
+		stream, err := client.ShowMenu(ctx, &ui.ShowMenuRequest{Items: items})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ev, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		streams[i], ids[i] = stream, ev.GetOpened().GetPopupId()
+	}
+
+	for i := len(menus) - 1; i >= 0; i-- {
+		execute(t, conn, fmt.Sprintf("call popup_close(%d, %d)", ids[i], i+1))
+	}
+	for i, stream := range streams {
+		ev, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("menu %d: %v", i, err)
+		}
+		closed := ev.GetClosed()
+		if closed.GetPopupId() != ids[i] || closed.GetItem() != menus[i][i] {
+			t.Errorf("menu %d: got close of popup %v selecting %q, want popup %v selecting %q",
+				i, closed.GetPopupId(), closed.GetItem(), ids[i], menus[i][i])
+		}
+	}
+}
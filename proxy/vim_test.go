@@ -0,0 +1,135 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ldelossa/vim-grpc.vim/proto"
+	commands "github.com/ldelossa/vim-grpc.vim/proto/commands"
+	diagnostics "github.com/ldelossa/vim-grpc.vim/proto/diagnostics"
+	editor "github.com/ldelossa/vim-grpc.vim/proto/editor"
+	ui "github.com/ldelossa/vim-grpc.vim/proto/ui"
+	windows "github.com/ldelossa/vim-grpc.vim/proto/windows"
+	"google.golang.org/grpc"
+)
+
+// startVim serves a Proxy and connects a headless Vim running the
+// plugin to it, returning a client of the Proxy's gRPC services.
+//
+// The test is skipped if Vim is not installed.
+func startVim(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+	vim, err := exec.LookPath("vim")
+	if err != nil {
+		t.Skip("vim not installed")
+	}
+	root, err := filepath.Abs("..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	// socket directories must be private, see NewListener.
+	if err := os.Chmod(dir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	opts := DefaultOptions()
+	opts.PingTimeout = 5 * time.Second
+	p := NewProxy(ctx, opts)
+	go p.Listen(ctx, "unix:"+filepath.Join(dir, VimSocket))
+	// Vim does not retry connecting.
+	for {
+		if _, err := os.Stat(filepath.Join(dir, VimSocket)); err == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	lis, err := NewListener("unix:"+filepath.Join(dir, GRPCSocket), GRPCSocket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(
+		grpc.UnaryInterceptor(UnaryInterceptor),
+		grpc.StreamInterceptor(StreamInterceptor),
+	)
+	editor.RegisterEditorServer(srv, p)
+	ui.RegisterUIServer(srv, p)
+	diagnostics.RegisterDiagnosticsServer(srv, p)
+	commands.RegisterCommandsServer(srv, p)
+	windows.RegisterWindowsServer(srv, p)
+	proto.RegisterProxyServer(srv, p)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	script := filepath.Join(dir, "setup.vim")
+	err = ioutil.WriteFile(script, []byte(fmt.Sprintf(`
+set nocompatible noswapfile
+let &rtp = %q . ',' . &rtp
+let g:vgrpc_address = %q
+runtime plugin/vgrpc.vim
+runtime plugin/vgrpc_router.vim
+VGRPCStart
+while 1
+  sleep 10m
+endwhile
+`, root, "unix:"+filepath.Join(dir, VimSocket))), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cmd := exec.CommandContext(ctx, vim, "-N", "-u", "NONE", "-i", "NONE", "--not-a-term", "-S", script)
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	})
+
+	conn, err := grpc.Dial("unix:"+filepath.Join(dir, GRPCSocket), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	// wait for Vim to connect.
+	client := editor.NewEditorClient(conn)
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		_, err := client.Eval(ctx, &editor.EvalRequest{Expr: "1"})
+		if err == nil {
+			return conn
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("vim did not connect: %v", err)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// eval evaluates an expression in the Vim started by startVim.
+func eval(t *testing.T, conn *grpc.ClientConn, expr string) interface{} {
+	t.Helper()
+	resp, err := editor.NewEditorClient(conn).Eval(context.Background(), &editor.EvalRequest{Expr: expr})
+	if err != nil {
+		t.Fatalf("evaluating %q: %v", expr, err)
+	}
+	return resp.Value.AsInterface()
+}
+
+// execute runs Ex commands in the Vim started by startVim.
+func execute(t *testing.T, conn *grpc.ClientConn, commands ...string) {
+	t.Helper()
+	_, err := editor.NewEditorClient(conn).Execute(context.Background(), &editor.ExecuteRequest{Commands: commands})
+	if err != nil {
+		t.Fatalf("executing %q: %v", commands, err)
+	}
+}
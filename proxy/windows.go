@@ -0,0 +1,194 @@
+package proxy
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/ldelossa/vim-grpc.vim/proto/windows"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WindowsService provides RPCs inspecting and manipulating
+// Vim's tabpages, windows and cursor.
+type WindowsService struct {
+	*Proxy
+	pb.UnimplementedWindowsServer
+}
+
+func NewWindowsService(ctx context.Context, proxy *Proxy) *WindowsService {
+	return &WindowsService{
+		Proxy: proxy,
+	}
+}
+
+// ListTabpages returns every tabpage along with its windows.
+func (w *WindowsService) ListTabpages(ctx context.Context, req *pb.ListTabpagesRequest) (*pb.ListTabpagesResponse, error) {
+	const (
+		RPC = "ListTabpages"
+	)
+
+	resp := &pb.ListTabpagesResponse{}
+	err := w.call(ctx, RPC, req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListWindows returns the windows of a tabpage, or of every
+// tabpage if none is provided.
+func (w *WindowsService) ListWindows(ctx context.Context, req *pb.ListWindowsRequest) (*pb.ListWindowsResponse, error) {
+	const (
+		RPC = "ListWindows"
+	)
+
+	if req.Tabnr < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tabpage number: %v", req.Tabnr)
+	}
+
+	resp := &pb.ListWindowsResponse{}
+	err := w.call(ctx, RPC, req, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// every tabpage has at least one window.
+	if req.Tabnr != 0 && len(resp.Windows) == 0 {
+		return nil, status.Errorf(codes.NotFound, "tabpage %v not found", req.Tabnr)
+	}
+	return resp, nil
+}
+
+// GetCursor returns the cursor position of a window.
+func (w *WindowsService) GetCursor(ctx context.Context, req *pb.GetCursorRequest) (*pb.GetCursorResponse, error) {
+	const (
+		RPC = "GetCursor"
+	)
+
+	resp := &pb.GetCursorResponse{}
+	err := w.call(ctx, RPC, req, resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Winid == 0 {
+		return nil, status.Errorf(codes.NotFound, "window %v not found", req.Winid)
+	}
+	return resp, nil
+}
+
+// SetCursor moves the cursor of a window.
+//
+// Positions beyond the end of the buffer or line are moved to
+// the last line or column, like Vim's cursor().
+func (w *WindowsService) SetCursor(ctx context.Context, req *pb.SetCursorRequest) (*pb.SetCursorResponse, error) {
+	const (
+		RPC = "SetCursor"
+	)
+
+	if req.Cursor == nil || req.Cursor.Lnum <= 0 || req.Cursor.Col < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cursor position: %v", req.Cursor)
+	}
+
+	_, err := w.alter(ctx, RPC, req, req.Winid)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetCursorResponse{}, nil
+}
+
+// SplitWindow splits a window and returns the id of the new window.
+func (w *WindowsService) SplitWindow(ctx context.Context, req *pb.SplitWindowRequest) (*pb.SplitWindowResponse, error) {
+	const (
+		RPC = "SplitWindow"
+	)
+
+	if req.Size < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid window size: %v", req.Size)
+	}
+	if req.Bufnr < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid buffer number: %v", req.Bufnr)
+	}
+
+	res, err := w.alter(ctx, RPC, req, req.Winid)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SplitWindowResponse{Winid: res.Winid}, nil
+}
+
+// CloseWindow closes a window.
+func (w *WindowsService) CloseWindow(ctx context.Context, req *pb.CloseWindowRequest) (*pb.CloseWindowResponse, error) {
+	const (
+		RPC = "CloseWindow"
+	)
+
+	if req.Winid <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid window id: %v", req.Winid)
+	}
+
+	_, err := w.alter(ctx, RPC, req, req.Winid)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CloseWindowResponse{}, nil
+}
+
+// FocusWindow makes a window the current window, switching
+// tabpages if necessary.
+func (w *WindowsService) FocusWindow(ctx context.Context, req *pb.FocusWindowRequest) (*pb.FocusWindowResponse, error) {
+	const (
+		RPC = "FocusWindow"
+	)
+
+	if req.Winid <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid window id: %v", req.Winid)
+	}
+
+	_, err := w.alter(ctx, RPC, req, req.Winid)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FocusWindowResponse{}, nil
+}
+
+// OpenFile opens a file in a window, a split of it or a new tabpage
+// and places the cursor at the requested position.
+//
+// The window displaying the file receives focus.
+func (w *WindowsService) OpenFile(ctx context.Context, req *pb.OpenFileRequest) (*pb.OpenFileResponse, error) {
+	const (
+		RPC = "OpenFile"
+	)
+
+	if req.Path == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "path must not be empty")
+	}
+	if req.Lnum < 0 || req.Col < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cursor position: %v:%v", req.Lnum, req.Col)
+	}
+
+	res, err := w.alter(ctx, RPC, req, req.Winid)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.OpenFileResponse{Winid: res.Winid, Bufnr: res.Bufnr}, nil
+}
+
+// alter issues a window altering RPC and maps Vim's WindowResult
+// to a gRPC status error on failure.
+func (w *WindowsService) alter(ctx context.Context, rpc string, req proto.Message, winid int64) (*pb.WindowResult, error) {
+	if winid < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid window id: %v", winid)
+	}
+
+	res := &pb.WindowResult{}
+	err := w.call(ctx, rpc, req, res)
+	if err != nil {
+		return nil, err
+	}
+	if res.Winid == 0 {
+		return nil, status.Errorf(codes.NotFound, "window %v not found", winid)
+	}
+	return res, nil
+}
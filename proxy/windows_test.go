@@ -0,0 +1,56 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "github.com/ldelossa/vim-grpc.vim/proto/windows"
+)
+
+// TestWindowsFocus alters windows other than the current one, only a
+// focusing split may move focus.
+func TestWindowsFocus(t *testing.T) {
+	conn := startVim(t)
+	client := pb.NewWindowsClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	current := func() int64 {
+		t.Helper()
+		return int64(eval(t, conn, "win_getid()").(float64))
+	}
+
+	execute(t, conn, "split")
+	cur := current()
+	other := int64(eval(t, conn, "win_getid(2)").(float64))
+
+	split, err := client.SplitWindow(ctx, &pb.SplitWindowRequest{Winid: other})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := current(); got != cur {
+		t.Fatalf("after splitting %v: current window %v, want %v", other, got, cur)
+	}
+
+	focused, err := client.SplitWindow(ctx, &pb.SplitWindowRequest{Winid: other, Focus: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := current(); got != focused.Winid {
+		t.Fatalf("after a focusing split: current window %v, want %v", got, focused.Winid)
+	}
+
+	execute(t, conn, fmt.Sprintf("call win_gotoid(%d)", cur))
+	for _, winid := range []int64{split.Winid, focused.Winid} {
+		if _, err := client.CloseWindow(ctx, &pb.CloseWindowRequest{Winid: winid}); err != nil {
+			t.Fatal(err)
+		}
+		if got := current(); got != cur {
+			t.Fatalf("after closing %v: current window %v, want %v", winid, got, cur)
+		}
+	}
+	if n := eval(t, conn, "winnr('$')").(float64); n != 2 {
+		t.Fatalf("got %v windows, want 2", n)
+	}
+}